@@ -1,9 +1,6 @@
 package gotime
 
-import (
-	"fmt"
-	"time"
-)
+import "time"
 
 // Age calculates the precise age in years, months, and days between a birth date and a reference date.
 // If no reference date is provided, it uses the current time.
@@ -21,7 +18,7 @@ func Age(birthDate time.Time, asOf ...time.Time) (years, months, days int) {
 	if len(asOf) > 0 {
 		ref = asOf[0]
 	} else {
-		ref = time.Now()
+		ref = Now()
 	}
 
 	// Ensure birth date is before reference date
@@ -152,7 +149,9 @@ func WeeksBetween(start, end time.Time) float64 {
 }
 
 // DurationInWords returns a human-readable representation of a duration.
-// It formats the duration in the most appropriate unit(s).
+// It formats the duration in the two most significant non-zero units from days
+// down to seconds. Use DurationInWordsWithOptions for other units and styles.
+// ParseHumanDuration parses the result back into a duration.
 //
 // Example:
 //
@@ -160,78 +159,7 @@ func WeeksBetween(start, end time.Time) float64 {
 //	result := gotime.DurationInWords(d)
 //	// Returns: "2 hours 30 minutes"
 func DurationInWords(d time.Duration) string {
-	if d == 0 {
-		return "0 seconds"
-	}
-
-	// Handle negative durations
-	negative := d < 0
-	if negative {
-		d = -d
-	}
-
-	var parts []string
-
-	// Extract time components
-	days := int(d.Hours()) / 24
-	hours := int(d.Hours()) % 24
-	minutes := int(d.Minutes()) % 60
-	seconds := int(d.Seconds()) % 60
-
-	// Build the string with appropriate units
-	if days > 0 {
-		if days == 1 {
-			parts = append(parts, "1 day")
-		} else {
-			parts = append(parts, fmt.Sprintf("%d days", days))
-		}
-	}
-
-	if hours > 0 {
-		if hours == 1 {
-			parts = append(parts, "1 hour")
-		} else {
-			parts = append(parts, fmt.Sprintf("%d hours", hours))
-		}
-	}
-
-	if minutes > 0 {
-		if minutes == 1 {
-			parts = append(parts, "1 minute")
-		} else {
-			parts = append(parts, fmt.Sprintf("%d minutes", minutes))
-		}
-	}
-
-	if seconds > 0 && len(parts) < 2 { // Only show seconds if we don't have 2+ larger units
-		if seconds == 1 {
-			parts = append(parts, "1 second")
-		} else {
-			parts = append(parts, fmt.Sprintf("%d seconds", seconds))
-		}
-	}
-
-	// Handle very small durations
-	if len(parts) == 0 {
-		return "less than 1 second"
-	}
-
-	// Join parts appropriately
-	var result string
-	if len(parts) == 1 {
-		result = parts[0]
-	} else if len(parts) == 2 {
-		result = parts[0] + " " + parts[1]
-	} else {
-		// For 3+ parts, use only the first 2 most significant units
-		result = parts[0] + " " + parts[1]
-	}
-
-	if negative {
-		result = "-" + result
-	}
-
-	return result
+	return DurationInWordsWithOptions(d, DurationInWordsOptions{MaxUnits: 2})
 }
 
 // IsValidAge checks if the given birth date results in a valid age (not negative, not unreasonably old).
@@ -247,7 +175,7 @@ func IsValidAge(birthDate time.Time, asOf ...time.Time) bool {
 	if len(asOf) > 0 {
 		ref = asOf[0]
 	} else {
-		ref = time.Now()
+		ref = Now()
 	}
 
 	// Birth date cannot be in the future
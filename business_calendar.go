@@ -6,8 +6,10 @@ import "time"
 //
 // The weekends parameter specifies which weekdays are considered weekends
 // (e.g., []time.Weekday{time.Saturday, time.Sunday}).
-// The holidays parameter is a slice of time.Time representing holidays
-// (only the date portion is considered, time is ignored).
+// The holidays parameter is a slice of time.Time representing holidays. Only the
+// date portion is considered, and each holiday is matched against t as seen in the
+// holiday's own location, so a UTC timestamp is checked against a local holiday
+// by its local date.
 //
 // Example:
 //	weekends := []time.Weekday{time.Saturday, time.Sunday}
@@ -21,7 +23,7 @@ func IsBusinessDay(t time.Time, weekends []time.Weekday, holidays ...time.Time)
 		}
 	}
 	for _, h := range holidays {
-		if sameDay(t, h) {
+		if SameDay(h, t, h.Location()) {
 			return false
 		}
 	}
@@ -58,9 +60,30 @@ func PrevBusinessDay(t time.Time, weekends []time.Weekday, holidays ...time.Time
 	return prev
 }
 
-// sameDay checks if two times are on the same year, month, and day.
-func sameDay(a, b time.Time) bool {
-	ay, am, ad := a.Date()
-	by, bm, bd := b.Date()
-	return ay == by && am == bm && ad == bd
+// holidaySet is a set of holiday dates for fast lookups. Dates are recorded in the
+// location of each holiday, so contains(t) matches SameDay(h, t, h.Location()).
+type holidaySet map[*time.Location]map[Date]bool
+
+// newHolidaySet builds a holidaySet from the given holidays.
+func newHolidaySet(holidays []time.Time) holidaySet {
+	set := holidaySet{}
+	for _, h := range holidays {
+		days, ok := set[h.Location()]
+		if !ok {
+			days = map[Date]bool{}
+			set[h.Location()] = days
+		}
+		days[DateOf(h)] = true
+	}
+	return set
+}
+
+// contains reports whether t falls on one of the holidays.
+func (s holidaySet) contains(t time.Time) bool {
+	for loc, days := range s {
+		if days[DateOf(t.In(loc))] {
+			return true
+		}
+	}
+	return false
 }
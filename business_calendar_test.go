@@ -81,3 +81,37 @@ func TestPrevBusinessDay(t *testing.T) {
 		})
 	}
 }
+
+func TestBusinessDaysWithZonedHolidays(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	weekends := []time.Weekday{time.Saturday, time.Sunday}
+	holidays := []time.Time{time.Date(2025, 7, 4, 0, 0, 0, 0, ny)}
+
+	// 2025-07-04 02:00 UTC is still Thursday July 3rd in New York.
+	if !gotime.IsBusinessDay(time.Date(2025, 7, 4, 2, 0, 0, 0, time.UTC), weekends, holidays...) {
+		t.Errorf("IsBusinessDay should read the UTC time in the holiday's location")
+	}
+	// 2025-07-04 14:00 UTC is July 4th in New York.
+	if gotime.IsBusinessDay(time.Date(2025, 7, 4, 14, 0, 0, 0, time.UTC), weekends, holidays...) {
+		t.Errorf("IsBusinessDay should treat July 4th in New York as a holiday")
+	}
+
+	workingDays := [7]bool{false, true, true, true, true, true, false}
+	start := time.Date(2025, 7, 3, 14, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 7, 7, 14, 0, 0, 0, time.UTC)
+	got, err := gotime.NetWorkDays(start, end, workingDays, holidays...)
+	if err != nil || got != 2 {
+		t.Errorf("NetWorkDays() = %v, %v, want 2", got, err)
+	}
+	next, err := gotime.WorkDay(start, 2, workingDays, holidays...)
+	if err != nil || !next.Equal(end) {
+		t.Errorf("WorkDay() = %v, %v, want %v", next, err, end)
+	}
+	prev, err := gotime.PrevWorkDay(end, 1, workingDays, holidays...)
+	if err != nil || !prev.Equal(start) {
+		t.Errorf("PrevWorkDay() = %v, %v, want %v", prev, err, start)
+	}
+}
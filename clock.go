@@ -0,0 +1,215 @@
+package gotime
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the current time. Every gotime function that defaults to the
+// current time reads it from the package clock through Now, so swapping the
+// clock with SetClock makes those functions deterministic in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock is the Clock backed by time.Now.
+type systemClock struct{}
+
+// Now returns time.Now().
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SystemClock is the real clock, backed by time.Now. It is the default package
+// clock.
+var SystemClock Clock = systemClock{}
+
+// packageClock is the clock read by Now.
+var packageClock = struct {
+	sync.RWMutex
+	clock Clock
+}{clock: SystemClock}
+
+// SetClock replaces the package clock and returns a function that restores the
+// previous one. A nil clock restores SystemClock.
+//
+// Example:
+//
+//	restore := gotime.SetClock(gotime.NewFakeClock(time.Date(2025, 7, 31, 23, 59, 0, 0, time.UTC)))
+//	defer restore()
+//	gotime.MonthEnd() // 2025-07-31 23:59:59.999999999
+func SetClock(c Clock) (restore func()) {
+	if c == nil {
+		c = SystemClock
+	}
+	packageClock.Lock()
+	prev := packageClock.clock
+	packageClock.clock = c
+	packageClock.Unlock()
+
+	return func() {
+		packageClock.Lock()
+		packageClock.clock = prev
+		packageClock.Unlock()
+	}
+}
+
+// Now returns the current time according to the package clock.
+func Now() time.Time {
+	packageClock.RLock()
+	c := packageClock.clock
+	packageClock.RUnlock()
+	return c.Now()
+}
+
+// FakeClock is a Clock under the caller's control. It starts frozen at the time
+// it was created with and moves only when it is set or advanced, unless it is
+// resumed, in which case it ticks along with the real clock. It is safe for
+// concurrent use.
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	running bool
+
+	// since is the real time at which the clock was last set while running.
+	since time.Time
+}
+
+// NewFakeClock returns a FakeClock frozen at t.
+//
+// Example:
+//
+//	clock := gotime.NewFakeClock(time.Date(2025, 7, 9, 10, 0, 0, 0, time.UTC))
+//	clock.Advance(90 * time.Minute)
+//	clock.Now() // 2025-07-09 11:30:00
+func NewFakeClock(t time.Time) *FakeClock {
+	return &FakeClock{now: t}
+}
+
+// Now returns the current time of the fake clock.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.current()
+}
+
+// current returns the current time. The caller must hold the lock.
+func (c *FakeClock) current() time.Time {
+	if c.running {
+		return c.now.Add(time.Since(c.since))
+	}
+	return c.now
+}
+
+// Set moves the clock to t.
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now, c.since = t, time.Now()
+}
+
+// Advance moves the clock forward by d, or back when d is negative.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now, c.since = c.current().Add(d), time.Now()
+}
+
+// Freeze stops the clock at its current time.
+func (c *FakeClock) Freeze() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now, c.running = c.current(), false
+}
+
+// Resume lets the clock tick along with the real clock from its current time.
+func (c *FakeClock) Resume() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.running {
+		c.since, c.running = time.Now(), true
+	}
+}
+
+// Clocked reads the current time from its own Clock instead of the package
+// clock, for code that injects a clock per instance. A nil Clock uses the
+// package clock.
+//
+// Functions that take an optional date, such as MonthEnd or TimeAgo, are given
+// the instance's time explicitly: gotime.MonthEnd(c.Now()). Clocked provides
+// the helpers that take no date.
+//
+// Example:
+//
+//	c := gotime.Clocked{Clock: gotime.NewFakeClock(time.Date(2025, 7, 9, 10, 0, 0, 0, time.UTC))}
+//	c.Yesterday() // 2025-07-08 10:00:00
+//	gotime.QuarterEnd(c.Now()) // 2025-09-30 23:59:59.999999999
+type Clocked struct {
+	Clock Clock
+}
+
+// Now returns the current time of the instance's clock.
+func (c Clocked) Now() time.Time {
+	if c.Clock == nil {
+		return Now()
+	}
+	return c.Clock.Now()
+}
+
+// Today returns the current date of the instance's clock, in the clock's
+// location.
+func (c Clocked) Today() Date {
+	return DateOf(c.Now())
+}
+
+// Yesterday returns the time one day before the instance's current time.
+func (c Clocked) Yesterday() time.Time {
+	return c.Now().AddDate(0, 0, -1)
+}
+
+// Tomorrow returns the time one day after the instance's current time.
+func (c Clocked) Tomorrow() time.Time {
+	return c.Now().AddDate(0, 0, 1)
+}
+
+// LastWeek returns the time one week before the instance's current time.
+func (c Clocked) LastWeek() time.Time {
+	return c.Now().AddDate(0, 0, -7)
+}
+
+// NextWeek returns the time one week after the instance's current time.
+func (c Clocked) NextWeek() time.Time {
+	return c.Now().AddDate(0, 0, 7)
+}
+
+// LastMonth returns the time one month before the instance's current time.
+func (c Clocked) LastMonth() time.Time {
+	return c.Now().AddDate(0, -1, 0)
+}
+
+// NextMonth returns the time one month after the instance's current time.
+func (c Clocked) NextMonth() time.Time {
+	return c.Now().AddDate(0, 1, 0)
+}
+
+// LastQuarter returns the same date and time in the quarter before the
+// instance's current time.
+func (c Clocked) LastQuarter() time.Time {
+	return Quarters(-1, c.Now())
+}
+
+// NextQuarter returns the same date and time in the quarter after the
+// instance's current time.
+func (c Clocked) NextQuarter() time.Time {
+	return Quarters(1, c.Now())
+}
+
+// LastYear returns the time one year before the instance's current time.
+func (c Clocked) LastYear() time.Time {
+	return c.Now().AddDate(-1, 0, 0)
+}
+
+// NextYear returns the time one year after the instance's current time.
+func (c Clocked) NextYear() time.Time {
+	return c.Now().AddDate(1, 0, 0)
+}
@@ -0,0 +1,104 @@
+package gotime_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2025, 7, 9, 10, 0, 0, 0, time.UTC)
+	clock := gotime.NewFakeClock(start)
+	utils.AssertEqual(t, start, clock.Now())
+
+	clock.Advance(90 * time.Minute)
+	utils.AssertEqual(t, start.Add(90*time.Minute), clock.Now())
+
+	clock.Advance(-2 * time.Hour)
+	utils.AssertEqual(t, start.Add(-30*time.Minute), clock.Now())
+
+	clock.Set(start)
+	utils.AssertEqual(t, start, clock.Now())
+
+	clock.Resume()
+	time.Sleep(5 * time.Millisecond)
+	utils.AssertEqual(t, true, clock.Now().After(start))
+
+	clock.Freeze()
+	frozen := clock.Now()
+	time.Sleep(5 * time.Millisecond)
+	utils.AssertEqual(t, frozen, clock.Now())
+
+	// Setting a running clock restarts it from the new time.
+	clock.Resume()
+	clock.Set(start)
+	clock.Freeze()
+	utils.AssertEqual(t, true, clock.Now().Sub(start) < time.Second)
+}
+
+func TestSetClock(t *testing.T) {
+	now := time.Date(2025, 7, 31, 23, 59, 0, 0, time.UTC)
+	clock := gotime.NewFakeClock(now)
+	restore := gotime.SetClock(clock)
+
+	utils.AssertEqual(t, now, gotime.Now())
+	utils.AssertEqual(t, time.Date(2025, 7, 31, 23, 59, 59, 999999999, time.UTC), gotime.MonthEnd())
+	utils.AssertEqual(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), gotime.YearStart())
+	utils.AssertEqual(t, time.Date(2025, 7, 30, 23, 59, 0, 0, time.UTC), gotime.Yesterday())
+	utils.AssertEqual(t, now.AddDate(0, -3, 0), gotime.LastQuarter())
+
+	clock.Advance(time.Minute)
+	utils.AssertEqual(t, time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), gotime.MonthStart())
+
+	born := time.Date(2000, 8, 1, 0, 0, 0, 0, time.UTC)
+	years, _, _ := gotime.Age(born)
+	utils.AssertEqual(t, 25, years)
+
+	ago := time.Date(2025, 7, 31, 22, 0, 0, 0, time.UTC)
+	utils.AssertEqual(t, gotime.TimeAgo(ago, gotime.Now()), gotime.TimeAgo(ago))
+
+	restore()
+	utils.AssertEqual(t, true, time.Since(gotime.Now()) < time.Minute)
+
+	// A nil clock restores the system clock.
+	restore = gotime.SetClock(clock)
+	gotime.SetClock(nil)
+	utils.AssertEqual(t, true, time.Since(gotime.Now()) < time.Minute)
+	restore()
+}
+
+func TestClocked(t *testing.T) {
+	now := time.Date(2025, 7, 9, 10, 0, 0, 0, time.UTC)
+	c := gotime.Clocked{Clock: gotime.NewFakeClock(now)}
+
+	utils.AssertEqual(t, now, c.Now())
+	utils.AssertEqual(t, gotime.Date{Year: 2025, Month: time.July, Day: 9}, c.Today())
+	utils.AssertEqual(t, now.AddDate(0, 0, -1), c.Yesterday())
+	utils.AssertEqual(t, now.AddDate(0, 0, 1), c.Tomorrow())
+	utils.AssertEqual(t, now.AddDate(0, 0, -7), c.LastWeek())
+	utils.AssertEqual(t, now.AddDate(0, 0, 7), c.NextWeek())
+	utils.AssertEqual(t, now.AddDate(0, -1, 0), c.LastMonth())
+	utils.AssertEqual(t, now.AddDate(0, 1, 0), c.NextMonth())
+	utils.AssertEqual(t, now.AddDate(0, -3, 0), c.LastQuarter())
+	utils.AssertEqual(t, now.AddDate(0, 3, 0), c.NextQuarter())
+	utils.AssertEqual(t, now.AddDate(-1, 0, 0), c.LastYear())
+	utils.AssertEqual(t, now.AddDate(1, 0, 0), c.NextYear())
+
+	// Without a clock the package clock is used.
+	defer gotime.SetClock(gotime.NewFakeClock(now))()
+	utils.AssertEqual(t, now, gotime.Clocked{}.Now())
+}
+
+func ExampleSetClock() {
+	restore := gotime.SetClock(gotime.NewFakeClock(time.Date(2025, 7, 31, 23, 59, 0, 0, time.UTC)))
+	defer restore()
+
+	fmt.Println(gotime.MonthEnd().Format("2006-01-02 15:04:05"))
+	fmt.Println(gotime.Tomorrow().Format("2006-01-02"))
+	// Output:
+	// 2025-07-31 23:59:59
+	// 2025-08-01
+}
@@ -0,0 +1,685 @@
+package gotime
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCron is returned when a cron expression cannot be parsed.
+var ErrInvalidCron = errors.New("invalid cron expression")
+
+// cronMacros are the predefined schedules accepted by ParseCron.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronMonthNames and cronDayNames are the names accepted in the month and day of
+// week fields.
+var (
+	cronMonthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	cronDayNames = map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}
+)
+
+// maxCronDays is how far Next and Prev search for a fire time, in days. It
+// covers the 28 year cycle of weekdays and leap years.
+const maxCronDays = 366 * 29
+
+// CronSchedule is a parsed cron expression. The fields control how fire times
+// are placed; ParseCron sets Policy to DSTShiftForward and leaves the rest
+// empty.
+type CronSchedule struct {
+	// Location is the zone the expression is evaluated in. If nil, the location
+	// of the time passed to Next, Prev or Between is used.
+	Location *time.Location
+
+	// Policy places fire times that fall in a DST gap or overlap. With the
+	// default DSTShiftForward, a time skipped by the clocks moving forward fires
+	// at the end of the gap and a repeated time fires once, at its first
+	// instant. With DSTReject such fire times are skipped.
+	Policy DSTPolicy
+
+	// BusinessDaysOnly skips fire times that are not business days according to
+	// IsBusinessDay with Weekends and Holidays. Weekends defaults to Saturday and
+	// Sunday when nil.
+	BusinessDaysOnly bool
+	Weekends         []time.Weekday
+	Holidays         []time.Time
+
+	expr string
+	spec cronSpec
+}
+
+// cronSpec holds the parsed fields of a cron expression as bit sets.
+type cronSpec struct {
+	second, minute, hour, dom, month, dow uint64
+
+	// domStar and dowStar record an unrestricted day of month or day of week,
+	// which decides how the two fields combine.
+	domStar, dowStar bool
+
+	// domLast holds the offsets of "L" and "L-n", domWeekday the days of "nW"
+	// with 0 for "LW", dowLast the weekdays of "nL" and dowNth the "n#k" entries.
+	domLast    []int
+	domWeekday []int
+	dowLast    uint64
+	dowNth     []WeekdayNum
+}
+
+// ParseCron parses a cron expression. It accepts:
+//
+//   - the standard five fields: minute, hour, day of month, month and day of
+//     week, or six fields with a leading seconds field
+//   - lists ("1,15"), ranges ("1-5"), steps ("*/10", "10-40/5", "5/15"), "*"
+//     and "?", and the names JAN-DEC and SUN-SAT; 7 is also Sunday
+//   - the macros @yearly, @annually, @monthly, @weekly, @daily, @midnight and
+//     @hourly
+//   - in the day of month field "L" (last day), "L-3" (three days before the
+//     last day), "15W" (the weekday nearest the 15th, within the month) and
+//     "LW" (the last weekday)
+//   - in the day of week field "5L" (the last Friday) and "5#3" (the third
+//     Friday)
+//   - a "CRON_TZ=<zone> " or "TZ=<zone> " prefix, which sets Location
+//
+// As in Vixie cron, when both the day of month and the day of week are
+// restricted a day matches if either of them does.
+//
+// Example:
+//
+//	c, err := gotime.ParseCron("0 9 * * MON-FRI")
+//	next := c.Next(time.Date(2025, 7, 4, 17, 0, 0, 0, time.UTC)) // Friday
+//	// next: 2025-07-07 09:00:00 (Monday)
+func ParseCron(expr string) (CronSchedule, error) {
+	invalid := func(reason string) (CronSchedule, error) {
+		return CronSchedule{}, fmt.Errorf("%w: %q: %s", ErrInvalidCron, expr, reason)
+	}
+
+	c := CronSchedule{expr: expr, Policy: DSTShiftForward}
+	spec := strings.TrimSpace(expr)
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		i := strings.IndexFunc(spec, func(r rune) bool { return r == ' ' || r == '\t' })
+		if i < 0 {
+			return invalid("missing fields")
+		}
+		_, zone, _ := strings.Cut(spec[:i], "=")
+		loc, err := LoadLocation(zone)
+		if err != nil {
+			return invalid(fmt.Sprintf("unknown zone %q", zone))
+		}
+		c.Location = loc
+		spec = strings.TrimSpace(spec[i:])
+	}
+	if strings.HasPrefix(spec, "@") {
+		macro, ok := cronMacros[strings.ToLower(spec)]
+		if !ok {
+			return invalid(fmt.Sprintf("unknown macro %q", spec))
+		}
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return invalid(fmt.Sprintf("expected 5 or 6 fields, got %d", len(fields)))
+	}
+
+	var err error
+	s := &c.spec
+	if s.second, _, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return invalid("seconds: " + err.Error())
+	}
+	if s.minute, _, err = parseCronField(fields[1], 0, 59, nil); err != nil {
+		return invalid("minutes: " + err.Error())
+	}
+	if s.hour, _, err = parseCronField(fields[2], 0, 23, nil); err != nil {
+		return invalid("hours: " + err.Error())
+	}
+	if err = s.parseDayOfMonth(fields[3]); err != nil {
+		return invalid("day of month: " + err.Error())
+	}
+	if s.month, _, err = parseCronField(fields[4], 1, 12, cronMonthNames); err != nil {
+		return invalid("month: " + err.Error())
+	}
+	if err = s.parseDayOfWeek(fields[5]); err != nil {
+		return invalid("day of week: " + err.Error())
+	}
+	return c, nil
+}
+
+// parseCronField parses a list of values, ranges and steps between min and max
+// into a bit set. It reports whether the field is a plain "*" or "?".
+func parseCronField(field string, min, max int, names map[string]int) (bits uint64, star bool, err error) {
+	for _, item := range strings.Split(field, ",") {
+		b, s, err := parseCronItem(item, min, max, names)
+		if err != nil {
+			return 0, false, err
+		}
+		bits |= b
+		star = star || s
+	}
+	return bits, star, nil
+}
+
+// parseCronItem parses a single value, range or step of a cron field.
+func parseCronItem(item string, min, max int, names map[string]int) (uint64, bool, error) {
+	rng, stepText, hasStep := strings.Cut(item, "/")
+	step := 1
+	if hasStep {
+		n, err := strconv.Atoi(stepText)
+		if err != nil || n < 1 {
+			return 0, false, fmt.Errorf("bad step %q", item)
+		}
+		step = n
+	}
+
+	value := func(s string) (int, error) {
+		if n, ok := names[strings.ToUpper(s)]; ok {
+			return n, nil
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n < min || n > max {
+			return 0, fmt.Errorf("bad value %q", s)
+		}
+		return n, nil
+	}
+
+	lo, hi := min, max
+	star := false
+	switch {
+	case rng == "*" || rng == "?":
+		star = !hasStep
+	case strings.Contains(rng, "-"):
+		a, b, _ := strings.Cut(rng, "-")
+		var err error
+		if lo, err = value(a); err != nil {
+			return 0, false, err
+		}
+		if hi, err = value(b); err != nil {
+			return 0, false, err
+		}
+		if hi < lo {
+			return 0, false, fmt.Errorf("bad range %q", rng)
+		}
+	default:
+		var err error
+		if lo, err = value(rng); err != nil {
+			return 0, false, err
+		}
+		if !hasStep {
+			hi = lo
+		}
+	}
+
+	var bits uint64
+	for n := lo; n <= hi; n += step {
+		bits |= 1 << uint(n)
+	}
+	return bits, star, nil
+}
+
+// parseDayOfMonth parses the day of month field, including L and W.
+func (s *cronSpec) parseDayOfMonth(field string) error {
+	var items []string
+	for _, item := range strings.Split(field, ",") {
+		upper := strings.ToUpper(item)
+		switch {
+		case upper == "L":
+			s.domLast = append(s.domLast, 0)
+		case upper == "LW":
+			s.domWeekday = append(s.domWeekday, 0)
+		case strings.HasPrefix(upper, "L-"):
+			n, err := strconv.Atoi(upper[2:])
+			if err != nil || n < 0 || n > 30 {
+				return fmt.Errorf("bad value %q", item)
+			}
+			s.domLast = append(s.domLast, n)
+		case strings.HasSuffix(upper, "W"):
+			n, err := strconv.Atoi(upper[:len(upper)-1])
+			if err != nil || n < 1 || n > 31 {
+				return fmt.Errorf("bad value %q", item)
+			}
+			s.domWeekday = append(s.domWeekday, n)
+		default:
+			items = append(items, item)
+		}
+	}
+	if len(items) > 0 {
+		var err error
+		if s.dom, s.domStar, err = parseCronField(strings.Join(items, ","), 1, 31, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseDayOfWeek parses the day of week field, including L and #.
+func (s *cronSpec) parseDayOfWeek(field string) error {
+	day := func(text string) (time.Weekday, bool) {
+		if n, ok := cronDayNames[strings.ToUpper(text)]; ok {
+			return time.Weekday(n), true
+		}
+		n, err := strconv.Atoi(text)
+		if err != nil || n < 0 || n > 7 {
+			return 0, false
+		}
+		return time.Weekday(n % 7), true
+	}
+
+	var items []string
+	for _, item := range strings.Split(field, ",") {
+		switch {
+		case strings.Contains(item, "#"):
+			d, k, _ := strings.Cut(item, "#")
+			wd, ok := day(d)
+			n, err := strconv.Atoi(k)
+			if !ok || err != nil || n < 1 || n > 5 {
+				return fmt.Errorf("bad value %q", item)
+			}
+			s.dowNth = append(s.dowNth, WeekdayNum{N: n, Weekday: wd})
+		case len(item) > 1 && strings.HasSuffix(strings.ToUpper(item), "L"):
+			wd, ok := day(item[:len(item)-1])
+			if !ok {
+				return fmt.Errorf("bad value %q", item)
+			}
+			s.dowLast |= 1 << uint(wd)
+		default:
+			items = append(items, item)
+		}
+	}
+	if len(items) > 0 {
+		bits, star, err := parseCronField(strings.Join(items, ","), 0, 7, cronDayNames)
+		if err != nil {
+			return err
+		}
+		// Fold 7 into Sunday.
+		if bits&(1<<7) != 0 {
+			bits = bits&^(1<<7) | 1
+		}
+		s.dow, s.dowStar = bits, star
+	}
+	return nil
+}
+
+// matchesDate reports whether the day of month, month and day of week fields
+// select the date.
+func (s cronSpec) matchesDate(d Date) bool {
+	if s.month&(1<<uint(d.Month)) == 0 {
+		return false
+	}
+	dim := DaysInMonth(d.Year, int(d.Month))
+	wd := d.Weekday()
+
+	domMatch := s.dom&(1<<uint(d.Day)) != 0
+	for _, off := range s.domLast {
+		domMatch = domMatch || d.Day == dim-off
+	}
+	for _, n := range s.domWeekday {
+		domMatch = domMatch || nearestWeekday(d.Year, d.Month, n, dim) == d.Day
+	}
+
+	dowMatch := s.dow&(1<<uint(wd)) != 0 || s.dowLast&(1<<uint(wd)) != 0 && d.Day+7 > dim
+	for _, w := range s.dowNth {
+		dowMatch = dowMatch || w.Weekday == wd && (d.Day-1)/7+1 == w.N
+	}
+
+	switch {
+	case s.domStar && s.dowStar:
+		return true
+	case s.domStar:
+		return dowMatch
+	case s.dowStar:
+		return domMatch
+	}
+	return domMatch || dowMatch
+}
+
+// nearestWeekday returns the Monday to Friday day nearest to the given day of
+// the month without leaving the month, or the last such day when day is 0. It
+// returns -1 when the month has no such day.
+func nearestWeekday(year int, month time.Month, day, dim int) int {
+	if day == 0 {
+		switch (Date{Year: year, Month: month, Day: dim}).Weekday() {
+		case time.Saturday:
+			return dim - 1
+		case time.Sunday:
+			return dim - 2
+		}
+		return dim
+	}
+	if day > dim {
+		return -1
+	}
+	switch (Date{Year: year, Month: month, Day: day}).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == dim {
+			return day - 2
+		}
+		return day + 1
+	}
+	return day
+}
+
+// String returns the expression the schedule was parsed from.
+func (c CronSchedule) String() string {
+	return c.expr
+}
+
+// Next returns the first fire time strictly after the given time, in the
+// schedule's location. It returns the zero time when the expression never
+// fires, such as "0 0 30 2 *".
+//
+// Example:
+//
+//	c, _ := gotime.ParseCron("*/15 * * * *")
+//	c.Next(time.Date(2025, 7, 9, 10, 7, 0, 0, time.UTC)) // 2025-07-09 10:15:00
+func (c CronSchedule) Next(after time.Time) time.Time {
+	return c.search(after, 1)
+}
+
+// Prev returns the last fire time strictly before the given time, in the
+// schedule's location. It returns the zero time when there is none.
+//
+// Example:
+//
+//	c, _ := gotime.ParseCron("0 9 * * MON-FRI")
+//	c.Prev(time.Date(2025, 7, 7, 9, 0, 0, 0, time.UTC)) // 2025-07-04 09:00:00
+func (c CronSchedule) Prev(before time.Time) time.Time {
+	return c.search(before, -1)
+}
+
+// Between returns the fire times from start to end (inclusive), in order. If
+// end is before start, the dates are swapped.
+//
+// Example:
+//
+//	c, _ := gotime.ParseCron("0 */6 * * *")
+//	start := time.Date(2025, 7, 9, 0, 0, 0, 0, time.UTC)
+//	c.Between(start, start.Add(12*time.Hour)) // 00:00, 06:00 and 12:00
+func (c CronSchedule) Between(start, end time.Time) []time.Time {
+	if end.Before(start) {
+		start, end = end, start
+	}
+	loc := c.location(start)
+
+	// Walk the hours of the range once. A DST transition moves a wall clock
+	// time by at most a day, so the days either side are included.
+	var list []time.Time
+	last := DateOf(end.In(loc)).AddDays(1)
+	for d := DateOf(start.In(loc)).AddDays(-1); !d.After(last); d = d.AddDays(1) {
+		if !c.spec.matchesDate(d) {
+			continue
+		}
+		for h := 0; h < 24; h++ {
+			if c.spec.hour&(1<<uint(h)) != 0 {
+				list = c.appendFires(list, loc, d, h, start, end)
+			}
+		}
+	}
+
+	// Fire times resolved across a transition can land out of order or on the
+	// same instant, as when a skipped time shifts to the end of the gap.
+	sort.Slice(list, func(i, j int) bool { return list[i].Before(list[j]) })
+	n := 0
+	for i, t := range list {
+		if i == 0 || !t.Equal(list[n-1]) {
+			list[n] = t
+			n++
+		}
+	}
+	return list[:n]
+}
+
+// appendFires appends the fire times of hour h of day d that fall from start to
+// end (inclusive).
+func (c CronSchedule) appendFires(list []time.Time, loc *time.Location, d Date, h int, start, end time.Time) []time.Time {
+	hourStart, ok := cronHourStart(loc, d, h)
+	if !ok {
+		for v, ok := c.spec.nextSecond(0, 1); ok; v, ok = c.spec.nextSecond(v+1, 1) {
+			fire, err := DateInLocation(loc, d.Year, int(d.Month), d.Day, h, v/60, v%60, 0, c.Policy)
+			if err == nil && !fire.Before(start) && !fire.After(end) && c.isBusinessDay(fire) {
+				list = append(list, fire)
+			}
+		}
+		return list
+	}
+	if !c.isBusinessDay(hourStart) {
+		return list
+	}
+
+	v := 0
+	if e := start.Sub(hourStart); e > 0 {
+		v = int((e + time.Second - 1) / time.Second)
+	}
+	for v, ok := c.spec.nextSecond(v, 1); ok; v, ok = c.spec.nextSecond(v+1, 1) {
+		fire := hourStart.Add(time.Duration(v) * time.Second)
+		if fire.After(end) {
+			break
+		}
+		list = append(list, fire)
+	}
+	return list
+}
+
+// search finds the nearest fire time after (dir 1) or before (dir -1) t.
+func (c CronSchedule) search(t time.Time, dir int) time.Time {
+	loc := c.location(t)
+	local := t.In(loc)
+	first := DateOf(local)
+
+	for i := 0; i <= maxCronDays; i++ {
+		d := first.AddDays(dir * i)
+		if !c.spec.matchesDate(d) {
+			continue
+		}
+
+		// A DST transition moves a wall clock time by at most a few hours, so
+		// wall clock times further than that from t can't be the answer.
+		from, to := 0, 23
+		if i == 0 {
+			if dir > 0 {
+				from = local.Hour() - 3
+			} else {
+				to = local.Hour() + 3
+			}
+		}
+
+		var best time.Time
+		bestHour := -1
+		for _, h := range cronHours(from, to, dir) {
+			if c.spec.hour&(1<<uint(h)) == 0 {
+				continue
+			}
+			if bestHour >= 0 && (h-bestHour)*dir > 3 {
+				break
+			}
+			fire := c.nearestFire(loc, d, h, t, dir)
+			if fire.IsZero() {
+				continue
+			}
+			if best.IsZero() || dir > 0 && fire.Before(best) || dir < 0 && fire.After(best) {
+				best, bestHour = fire, h
+			}
+		}
+		if !best.IsZero() {
+			return best
+		}
+	}
+	return time.Time{}
+}
+
+// nearestFire returns the fire time of hour h of day d nearest to t, after
+// (dir 1) or before (dir -1) it, or the zero time when there is none.
+func (c CronSchedule) nearestFire(loc *time.Location, d Date, h int, t time.Time, dir int) time.Time {
+	hourStart, ok := cronHourStart(loc, d, h)
+	if !ok {
+		// Wall clock times in an hour that touches a DST transition are
+		// resolved one by one with the schedule's policy.
+		var best time.Time
+		for v, ok := c.spec.nextSecond(0, 1); ok; v, ok = c.spec.nextSecond(v+1, 1) {
+			fire, err := DateInLocation(loc, d.Year, int(d.Month), d.Day, h, v/60, v%60, 0, c.Policy)
+			if err != nil {
+				continue
+			}
+			better := best.IsZero() || dir > 0 && fire.Before(best) || dir < 0 && fire.After(best)
+			if better && (dir > 0 && fire.After(t) || dir < 0 && fire.Before(t)) && c.isBusinessDay(fire) {
+				best = fire
+			}
+		}
+		return best
+	}
+	if !c.isBusinessDay(hourStart) {
+		return time.Time{}
+	}
+
+	// The first second strictly after t, or the last one strictly before it.
+	e := t.Sub(hourStart)
+	v := 0
+	if dir < 0 {
+		v = 3599
+	}
+	switch {
+	case dir > 0 && e >= 0:
+		v = int(e/time.Second) + 1
+	case dir < 0 && e < time.Hour:
+		v = int((e+time.Second-1)/time.Second) - 1
+		if e < 0 {
+			return time.Time{}
+		}
+	}
+	if v, ok := c.spec.nextSecond(v, dir); ok {
+		return hourStart.Add(time.Duration(v) * time.Second)
+	}
+	return time.Time{}
+}
+
+// location returns the zone the schedule is evaluated in for t.
+func (c CronSchedule) location(t time.Time) *time.Location {
+	if c.Location == nil {
+		return t.Location()
+	}
+	return c.Location
+}
+
+// cronHourStart returns the instant hour h of day d starts at in loc. It
+// reports false when the hour touches a DST transition, so that its wall clock
+// times are not simply the start plus their minutes and seconds.
+func cronHourStart(loc *time.Location, d Date, h int) (time.Time, bool) {
+	wall := time.Date(d.Year, d.Month, d.Day, h, 0, 0, 0, time.UTC)
+	_, off := time.Date(d.Year, d.Month, d.Day, h, 0, 0, 0, loc).Zone()
+	start := wall.Add(-time.Duration(off) * time.Second).In(loc)
+	if _, actual := start.Zone(); actual != off {
+		return time.Time{}, false
+	}
+
+	// The hour must lie within one zone period, and the wall clock times of
+	// the periods either side must stay out of it.
+	periodStart, periodEnd := start.ZoneBounds()
+	if !periodStart.IsZero() {
+		_, prev := periodStart.Add(-time.Second).Zone()
+		if periodStart.After(start) || periodStart.Add(time.Duration(prev)*time.Second).After(wall) {
+			return time.Time{}, false
+		}
+	}
+	if !periodEnd.IsZero() {
+		_, next := periodEnd.Zone()
+		if periodEnd.Before(start.Add(time.Hour)) || periodEnd.Add(time.Duration(next)*time.Second).Before(wall.Add(time.Hour)) {
+			return time.Time{}, false
+		}
+	}
+	return start, true
+}
+
+// cronHours returns the hours from to to, clamped to a day, in the search
+// direction.
+func cronHours(from, to, dir int) []int {
+	if from < 0 {
+		from = 0
+	}
+	if to > 23 {
+		to = 23
+	}
+	var hours []int
+	for h := from; h <= to; h++ {
+		hours = append(hours, h)
+	}
+	if dir < 0 {
+		for i, j := 0, len(hours)-1; i < j; i, j = i+1, j-1 {
+			hours[i], hours[j] = hours[j], hours[i]
+		}
+	}
+	return hours
+}
+
+// nextSecond returns the first selected second of an hour, counted from the
+// start of the hour, at or after v (dir 1) or at or before v (dir -1).
+func (s cronSpec) nextSecond(v, dir int) (int, bool) {
+	if v < 0 || v >= 3600 {
+		return 0, false
+	}
+	for m, ok := nextBit(s.minute, v/60, dir); ok; m, ok = nextBit(s.minute, m+dir, dir) {
+		sec := 0
+		switch {
+		case m == v/60:
+			sec = v % 60
+		case dir < 0:
+			sec = 59
+		}
+		if sec, ok := nextBit(s.second, sec, dir); ok {
+			return m*60 + sec, true
+		}
+	}
+	return 0, false
+}
+
+// nextBit returns the first bit set in set at or after i (dir 1) or at or
+// before i (dir -1).
+func nextBit(set uint64, i, dir int) (int, bool) {
+	if i < 0 || i > 63 {
+		return 0, false
+	}
+	if dir > 0 {
+		if rest := set >> uint(i) << uint(i); rest != 0 {
+			return bits.TrailingZeros64(rest), true
+		}
+		return 0, false
+	}
+	if rest := set << uint(63-i) >> uint(63-i); rest != 0 {
+		return 63 - bits.LeadingZeros64(rest), true
+	}
+	return 0, false
+}
+
+// isBusinessDay reports whether a fire time is allowed by BusinessDaysOnly.
+func (c CronSchedule) isBusinessDay(t time.Time) bool {
+	if !c.BusinessDaysOnly {
+		return true
+	}
+	weekends := c.Weekends
+	if weekends == nil {
+		weekends = []time.Weekday{time.Saturday, time.Sunday}
+	}
+	return IsBusinessDay(t, weekends, c.Holidays...)
+}
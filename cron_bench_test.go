@@ -0,0 +1,38 @@
+package gotime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+)
+
+func BenchmarkCronNextDense(b *testing.B) {
+	c, _ := gotime.ParseCron("* * * * * *")
+	c.Location, _ = time.LoadLocation("America/New_York")
+	t := time.Date(2025, 7, 9, 10, 7, 30, 0, time.UTC)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = c.Next(t)
+	}
+}
+
+func BenchmarkCronBetweenDense(b *testing.B) {
+	c, _ := gotime.ParseCron("* * * * * *")
+	c.Location, _ = time.LoadLocation("America/New_York")
+	start := time.Date(2025, 7, 9, 10, 0, 0, 0, time.UTC)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = c.Between(start, start.Add(time.Hour))
+	}
+}
+
+func BenchmarkCronBetweenDenseDST(b *testing.B) {
+	c, _ := gotime.ParseCron("* * * * * *")
+	c.Location, _ = time.LoadLocation("America/New_York")
+	start := time.Date(2025, 11, 2, 4, 0, 0, 0, time.UTC)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = c.Between(start, start.Add(3*time.Hour))
+	}
+}
@@ -0,0 +1,226 @@
+package gotime_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestCronNext(t *testing.T) {
+	utc := func(y int, m time.Month, d, h, mi, s int) time.Time { return time.Date(y, m, d, h, mi, s, 0, time.UTC) }
+	// Wednesday.
+	base := utc(2025, 7, 9, 10, 7, 30)
+
+	tests := []struct {
+		expr  string
+		after time.Time
+		want  time.Time
+	}{
+		{"*/15 * * * *", base, utc(2025, 7, 9, 10, 15, 0)},
+		{"* * * * *", base, utc(2025, 7, 9, 10, 8, 0)},
+		{"7 10 * * *", base, utc(2025, 7, 10, 10, 7, 0)},
+		{"0 9 * * MON-FRI", utc(2025, 7, 4, 17, 0, 0), utc(2025, 7, 7, 9, 0, 0)},
+		{"0 9 * * 1-5", utc(2025, 7, 4, 17, 0, 0), utc(2025, 7, 7, 9, 0, 0)},
+		{"30 8 * * 7", base, utc(2025, 7, 13, 8, 30, 0)},
+		{"0 0 1,15 * *", base, utc(2025, 7, 15, 0, 0, 0)},
+		{"0 12 * JAN,JUL *", utc(2025, 7, 31, 13, 0, 0), utc(2026, 1, 1, 12, 0, 0)},
+		{"10-40/10 * * * *", base, utc(2025, 7, 9, 10, 10, 0)},
+		{"5/20 * * * *", utc(2025, 7, 9, 10, 46, 0), utc(2025, 7, 9, 11, 5, 0)},
+		{"0 0 29 2 *", base, utc(2028, 2, 29, 0, 0, 0)},
+
+		{"45 * * * * *", base, utc(2025, 7, 9, 10, 7, 45)},
+		{"*/10 * * * * *", base, utc(2025, 7, 9, 10, 7, 40)},
+
+		{"@hourly", base, utc(2025, 7, 9, 11, 0, 0)},
+		{"@daily", base, utc(2025, 7, 10, 0, 0, 0)},
+		{"@midnight", base, utc(2025, 7, 10, 0, 0, 0)},
+		{"@weekly", base, utc(2025, 7, 13, 0, 0, 0)},
+		{"@monthly", base, utc(2025, 8, 1, 0, 0, 0)},
+		{"@yearly", base, utc(2026, 1, 1, 0, 0, 0)},
+		{"@annually", base, utc(2026, 1, 1, 0, 0, 0)},
+
+		{"0 0 L * *", base, utc(2025, 7, 31, 0, 0, 0)},
+		{"0 0 L * *", utc(2025, 2, 1, 0, 0, 0), utc(2025, 2, 28, 0, 0, 0)},
+		{"0 0 L-2 * *", base, utc(2025, 7, 29, 0, 0, 0)},
+		{"0 0 15W * *", utc(2025, 6, 1, 0, 0, 0), utc(2025, 6, 16, 0, 0, 0)},
+		{"0 0 14W * *", utc(2025, 6, 1, 0, 0, 0), utc(2025, 6, 13, 0, 0, 0)},
+		{"0 0 1W * *", utc(2025, 10, 31, 12, 0, 0), utc(2025, 11, 3, 0, 0, 0)},
+		{"0 0 31W * *", utc(2025, 8, 1, 0, 0, 0), utc(2025, 8, 29, 0, 0, 0)},
+		{"0 0 LW * *", utc(2025, 8, 1, 0, 0, 0), utc(2025, 8, 29, 0, 0, 0)},
+		{"0 0 * * 5L", base, utc(2025, 7, 25, 0, 0, 0)},
+		{"0 0 * * FRIL", base, utc(2025, 7, 25, 0, 0, 0)},
+		{"0 0 * * 1#2", base, utc(2025, 7, 14, 0, 0, 0)},
+		{"0 0 ? * MON#1", base, utc(2025, 8, 4, 0, 0, 0)},
+
+		// Day of month and day of week restricted together match either.
+		{"0 0 13 * 5", utc(2025, 6, 1, 0, 0, 0), utc(2025, 6, 6, 0, 0, 0)},
+		{"0 0 13 * 5", utc(2025, 6, 12, 0, 0, 0), utc(2025, 6, 13, 0, 0, 0)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			c, err := gotime.ParseCron(tc.expr)
+			utils.AssertEqual(t, nil, err)
+			utils.AssertEqual(t, tc.want, c.Next(tc.after))
+		})
+	}
+}
+
+func TestCronPrevAndBetween(t *testing.T) {
+	c, _ := gotime.ParseCron("0 9 * * MON-FRI")
+	monday := time.Date(2025, 7, 7, 9, 0, 0, 0, time.UTC)
+	utils.AssertEqual(t, time.Date(2025, 7, 4, 9, 0, 0, 0, time.UTC), c.Prev(monday))
+	utils.AssertEqual(t, monday, c.Prev(monday.Add(time.Second)))
+
+	c, _ = gotime.ParseCron("0 0 L * *")
+	utils.AssertEqual(t, time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC), c.Prev(time.Date(2025, 7, 9, 0, 0, 0, 0, time.UTC)))
+
+	c, _ = gotime.ParseCron("0 */6 * * *")
+	start := time.Date(2025, 7, 9, 0, 0, 0, 0, time.UTC)
+	utils.AssertEqual(t, []time.Time{
+		start,
+		start.Add(6 * time.Hour),
+		start.Add(12 * time.Hour),
+		start.Add(18 * time.Hour),
+		start.Add(24 * time.Hour),
+	}, c.Between(start.Add(24*time.Hour), start))
+
+	c, _ = gotime.ParseCron("0 0 30 2 *")
+	utils.AssertEqual(t, true, c.Next(start).IsZero())
+	utils.AssertEqual(t, true, c.Prev(start).IsZero())
+	utils.AssertEqual(t, 0, len(c.Between(start, start.AddDate(1, 0, 0))))
+
+	c, _ = gotime.ParseCron("* * * * * *")
+	got := c.Between(start, start.Add(time.Minute))
+	utils.AssertEqual(t, 61, len(got))
+	utils.AssertEqual(t, start.Add(time.Minute), got[60])
+	utils.AssertEqual(t, start.Add(time.Second), c.Next(start.Add(time.Millisecond)))
+	utils.AssertEqual(t, start, c.Prev(start.Add(time.Millisecond)))
+}
+
+func TestCronLocation(t *testing.T) {
+	tokyo := mustLoad(t, "Asia/Tokyo")
+	after := time.Date(2025, 7, 9, 0, 0, 0, 0, time.UTC) // 09:00 in Tokyo
+
+	c, _ := gotime.ParseCron("30 9 * * *")
+	c.Location = tokyo
+	got := c.Next(after)
+	utils.AssertEqual(t, time.Date(2025, 7, 9, 9, 30, 0, 0, tokyo), got)
+	utils.AssertEqual(t, tokyo, got.Location())
+
+	c, err := gotime.ParseCron("CRON_TZ=Asia/Tokyo 30 9 * * *")
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, time.Date(2025, 7, 9, 9, 30, 0, 0, tokyo), c.Next(after))
+	utils.AssertEqual(t, "CRON_TZ=Asia/Tokyo 30 9 * * *", c.String())
+
+	c, _ = gotime.ParseCron("TZ=Asia/Tokyo @daily")
+	utils.AssertEqual(t, time.Date(2025, 7, 10, 0, 0, 0, 0, tokyo), c.Next(after))
+
+	// Without a location the zone of the argument is used.
+	c, _ = gotime.ParseCron("30 9 * * *")
+	utils.AssertEqual(t, time.Date(2025, 7, 9, 9, 30, 0, 0, time.UTC), c.Next(after))
+}
+
+func TestCronDST(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	// 02:30 doesn't exist on 2025-03-09.
+	c, _ := gotime.ParseCron("30 2 * * *")
+	c.Location = ny
+	after := time.Date(2025, 3, 8, 3, 0, 0, 0, ny)
+	utils.AssertEqual(t, time.Date(2025, 3, 9, 3, 0, 0, 0, ny), c.Next(after))
+
+	c.Policy = gotime.DSTReject
+	utils.AssertEqual(t, time.Date(2025, 3, 10, 2, 30, 0, 0, ny), c.Next(after))
+
+	// 01:30 happens twice on 2025-11-02 and fires once.
+	c, _ = gotime.ParseCron("30 1 * * *")
+	c.Location = ny
+	first := c.Next(time.Date(2025, 11, 2, 0, 0, 0, 0, ny))
+	utils.AssertEqual(t, time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC), first)
+	utils.AssertEqual(t, time.Date(2025, 11, 3, 6, 30, 0, 0, time.UTC), c.Next(first))
+	utils.AssertEqual(t, first, c.Prev(time.Date(2025, 11, 3, 0, 0, 0, 0, ny)))
+
+	c.Policy = gotime.DSTLatest
+	utils.AssertEqual(t, time.Date(2025, 11, 2, 6, 30, 0, 0, time.UTC), c.Next(time.Date(2025, 11, 2, 0, 0, 0, 0, ny)))
+
+	// Hourly jobs run once per real hour across the fall back.
+	c, _ = gotime.ParseCron("0 * * * *")
+	c.Location = ny
+	start := time.Date(2025, 11, 2, 0, 0, 0, 0, ny)
+	got := c.Between(start, start.Add(4*time.Hour))
+	utils.AssertEqual(t, 4, len(got))
+	for i := 1; i < len(got); i++ {
+		utils.AssertEqual(t, true, got[i].After(got[i-1]))
+	}
+
+	// Every second fires once across the fall back, and the repeated hour
+	// only at its first instants.
+	c, _ = gotime.ParseCron("* * * * * *")
+	c.Location = ny
+	start = time.Date(2025, 11, 2, 4, 0, 0, 0, time.UTC)
+	got = c.Between(start, start.Add(3*time.Hour))
+	utils.AssertEqual(t, 2*3600+1, len(got))
+	utils.AssertEqual(t, time.Date(2025, 11, 2, 5, 59, 59, 0, time.UTC), got[2*3600-1])
+	utils.AssertEqual(t, time.Date(2025, 11, 2, 7, 0, 0, 0, time.UTC), got[2*3600])
+	utils.AssertEqual(t, time.Date(2025, 11, 2, 5, 59, 59, 0, time.UTC), c.Prev(time.Date(2025, 11, 2, 6, 30, 0, 0, time.UTC)))
+	utils.AssertEqual(t, time.Date(2025, 11, 2, 1, 0, 1, 0, ny), c.Next(time.Date(2025, 11, 2, 1, 0, 0, 500, ny)))
+}
+
+func TestCronBusinessDays(t *testing.T) {
+	c, _ := gotime.ParseCron("0 9 * * *")
+	c.BusinessDaysOnly = true
+	c.Holidays = []time.Time{time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC)}
+
+	thursday := time.Date(2025, 7, 3, 10, 0, 0, 0, time.UTC)
+	utils.AssertEqual(t, time.Date(2025, 7, 7, 9, 0, 0, 0, time.UTC), c.Next(thursday))
+	utils.AssertEqual(t, time.Date(2025, 7, 3, 9, 0, 0, 0, time.UTC), c.Prev(time.Date(2025, 7, 7, 0, 0, 0, 0, time.UTC)))
+
+	// Custom weekends: Friday and Saturday.
+	c.Weekends = []time.Weekday{time.Friday, time.Saturday}
+	c.Holidays = nil
+	utils.AssertEqual(t, time.Date(2025, 7, 6, 9, 0, 0, 0, time.UTC), c.Next(thursday))
+}
+
+func TestParseCronInvalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * 32 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"* * * FOO *",
+		"@fortnightly",
+		"* * L-31 * *",
+		"* * 32W * *",
+		"* * * * 1#6",
+		"* * * * 9L",
+		"CRON_TZ=Mars/Olympus * * * * *",
+		"CRON_TZ=UTC",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			_, err := gotime.ParseCron(expr)
+			utils.AssertEqual(t, true, errors.Is(err, gotime.ErrInvalidCron))
+		})
+	}
+}
+
+func ExampleParseCron() {
+	c, _ := gotime.ParseCron("0 9 * * MON-FRI")
+	friday := time.Date(2025, 7, 4, 17, 0, 0, 0, time.UTC)
+
+	fmt.Println(c.Next(friday).Format("Mon 2006-01-02 15:04"))
+	fmt.Println(c.Prev(friday).Format("Mon 2006-01-02 15:04"))
+	// Output:
+	// Mon 2025-07-07 09:00
+	// Fri 2025-07-04 09:00
+}
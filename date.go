@@ -0,0 +1,294 @@
+package gotime
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maniartech/gotime/v2/internal/nites"
+)
+
+// ErrUnsupportedScanType is returned when a database value cannot be scanned
+// into one of the gotime value types.
+var ErrUnsupportedScanType = errors.New("unsupported scan source type")
+
+// isoDateLayout is the layout used by Date for its text, JSON and SQL forms.
+const isoDateLayout = "2006-01-02"
+
+// Date is a calendar date without a time of day or a location, such as a
+// birthday or a holiday. Unlike a time.Time at midnight, a Date reads the same
+// in every zone, so it is never off by one day when moved between zones.
+//
+// The zero value (0000-00-00) represents "no date". It is encoded as null in
+// JSON and as NULL in SQL. Date values are comparable with == and can be used
+// as map keys.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the calendar date of t in its own location. Use t.In(loc) first
+// to read the date in another zone.
+//
+// Example:
+//
+//	ny, _ := gotime.LoadLocation("America/New_York")
+//	t := time.Date(2025, 7, 4, 2, 0, 0, 0, time.UTC)
+//	gotime.DateOf(t)        // 2025-07-04
+//	gotime.DateOf(t.In(ny)) // 2025-07-03
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// Today returns the current date in the local time zone.
+//
+// Example:
+//
+//	today := gotime.Today()
+//	// today: the current local date, such as 2025-07-08
+func Today() Date {
+	return DateOf(Now())
+}
+
+// ParseDate parses a date string according to the specified NITES layout and
+// returns its calendar date. Any time of day in the value is ignored. If layout
+// is empty, "yyyy-mm-dd" is used.
+//
+// Example:
+//
+//	d, err := gotime.ParseDate("dd/mm/yyyy", "04/07/2025")
+//	// d: 2025-07-04
+func ParseDate(layout, value string) (Date, error) {
+	if layout == "" {
+		layout = "yyyy-mm-dd"
+	}
+	t, err := parseValue(layout, value, time.UTC)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// utc returns midnight UTC of the date, normalizing out of range components.
+func (d Date) utc() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// IsZero reports whether d is the zero Date.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// IsValid reports whether d names an existing calendar date, so that
+// 2025-02-29 and the zero Date are invalid.
+func (d Date) IsValid() bool {
+	return !d.IsZero() && DateOf(d.utc()) == d
+}
+
+// In returns the first instant of the date in loc. When midnight is skipped by a
+// DST transition, this is the first instant after the gap.
+//
+// Example:
+//
+//	d := gotime.Date{Year: 2025, Month: time.July, Day: 4}
+//	d.In(time.UTC) // 2025-07-04 00:00:00 +0000 UTC
+func (d Date) In(loc *time.Location) time.Time {
+	return startOfDay(d.Year, d.Month, d.Day, loc)
+}
+
+// AddDays returns the date n days after d. Negative values go back in time.
+//
+// Example:
+//
+//	d := gotime.Date{Year: 2025, Month: time.February, Day: 27}
+//	d.AddDays(2) // 2025-03-01
+func (d Date) AddDays(n int) Date {
+	return d.AddDate(0, 0, n)
+}
+
+// AddMonths returns the date n months after d. Like time.AddDate, a day that
+// doesn't exist in the target month overflows into the next one.
+//
+// Example:
+//
+//	d := gotime.Date{Year: 2025, Month: time.January, Day: 31}
+//	d.AddMonths(1) // 2025-03-03
+func (d Date) AddMonths(n int) Date {
+	return d.AddDate(0, n, 0)
+}
+
+// AddYears returns the date n years after d. February 29th moves to March 1st in
+// years that are not leap years.
+//
+// Example:
+//
+//	d := gotime.Date{Year: 2024, Month: time.February, Day: 29}
+//	d.AddYears(1) // 2025-03-01
+func (d Date) AddYears(n int) Date {
+	return d.AddDate(n, 0, 0)
+}
+
+// AddDate returns the date after adding the given number of years, months and
+// days to d. It normalizes the result like time.AddDate.
+//
+// Example:
+//
+//	d := gotime.Date{Year: 2025, Month: time.July, Day: 4}
+//	d.AddDate(1, 2, 3) // 2026-09-07
+func (d Date) AddDate(years, months, days int) Date {
+	return DateOf(d.utc().AddDate(years, months, days))
+}
+
+// DaysSince returns the number of days from u to d. It is negative when d is
+// before u.
+//
+// Example:
+//
+//	d := gotime.Date{Year: 2025, Month: time.July, Day: 4}
+//	d.DaysSince(gotime.Date{Year: 2025, Month: time.January, Day: 1}) // 184
+func (d Date) DaysSince(u Date) int {
+	return int(d.utc().Sub(u.utc()).Hours() / 24)
+}
+
+// Compare returns -1 if d is before u, 0 if they are the same date and +1 if d
+// is after u.
+func (d Date) Compare(u Date) int {
+	switch {
+	case d.Before(u):
+		return -1
+	case d.After(u):
+		return 1
+	}
+	return 0
+}
+
+// Before reports whether d is before u.
+func (d Date) Before(u Date) bool {
+	if d.Year != u.Year {
+		return d.Year < u.Year
+	}
+	if d.Month != u.Month {
+		return d.Month < u.Month
+	}
+	return d.Day < u.Day
+}
+
+// After reports whether d is after u.
+func (d Date) After(u Date) bool {
+	return u.Before(d)
+}
+
+// Weekday returns the day of the week of d.
+func (d Date) Weekday() time.Weekday {
+	return d.utc().Weekday()
+}
+
+// YearDay returns the day of the year of d, in the range [1, 366].
+func (d Date) YearDay() int {
+	return d.utc().YearDay()
+}
+
+// String returns the date in the ISO 8601 form yyyy-mm-dd.
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, int(d.Month), d.Day)
+}
+
+// Format returns the date formatted with the specified NITES layout. Time
+// specifiers in the layout format as midnight UTC. If layout is empty,
+// "yyyy-mm-dd" is used.
+//
+// Example:
+//
+//	d := gotime.Date{Year: 2025, Month: time.July, Day: 4}
+//	d.Format("wwww, mmmm dt yyyy") // "Friday, July 4th 2025"
+func (d Date) Format(layout string) string {
+	if layout == "" {
+		layout = "yyyy-mm-dd"
+	}
+	return nites.Format(d.utc(), layout)
+}
+
+// MarshalText implements encoding.TextMarshaler using the yyyy-mm-dd form. The
+// zero Date is encoded as empty text.
+func (d Date) MarshalText() ([]byte, error) {
+	if d.IsZero() {
+		return []byte{}, nil
+	}
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the yyyy-mm-dd
+// form and leaves the zero Date for empty input or "0000-00-00".
+func (d *Date) UnmarshalText(data []byte) error {
+	if len(data) == 0 || string(data) == (Date{}).String() {
+		*d = Date{}
+		return nil
+	}
+	t, err := time.Parse(isoDateLayout, string(data))
+	if err != nil {
+		return err
+	}
+	*d = DateOf(t)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The zero Date is encoded as null.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts a "yyyy-mm-dd" string
+// or null, which leaves the zero Date.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		*d = Date{}
+		return nil
+	}
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return fmt.Errorf("gotime: Date must be a JSON string, got %s", s)
+	}
+	return d.UnmarshalText([]byte(s[1 : len(s)-1]))
+}
+
+// Value implements driver.Valuer. The zero Date is stored as NULL and other
+// dates as a "yyyy-mm-dd" string, which SQL DATE columns accept.
+func (d Date) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return d.String(), nil
+}
+
+// Scan implements sql.Scanner. It accepts NULL, a time.Time (using its own
+// location, which is how drivers return DATE columns) and "yyyy-mm-dd" text,
+// optionally followed by a time of day.
+func (d *Date) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*d = Date{}
+		return nil
+	case time.Time:
+		*d = DateOf(v)
+		return nil
+	case string:
+		return d.scanText(v)
+	case []byte:
+		return d.scanText(string(v))
+	}
+	return fmt.Errorf("%w: cannot scan %T into gotime.Date", ErrUnsupportedScanType, src)
+}
+
+// scanText parses the date part of a textual database value.
+func (d *Date) scanText(s string) error {
+	if len(s) > len(isoDateLayout) && (s[len(isoDateLayout)] == 'T' || s[len(isoDateLayout)] == ' ') {
+		s = s[:len(isoDateLayout)]
+	}
+	return d.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,209 @@
+package gotime_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestDateOfAndIn(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	santiago := mustLoad(t, "America/Santiago")
+
+	ts := time.Date(2025, 7, 4, 2, 0, 0, 0, time.UTC)
+	utils.AssertEqual(t, gotime.Date{Year: 2025, Month: time.July, Day: 4}, gotime.DateOf(ts))
+	utils.AssertEqual(t, gotime.Date{Year: 2025, Month: time.July, Day: 3}, gotime.DateOf(ts.In(ny)))
+
+	d := gotime.Date{Year: 2025, Month: time.July, Day: 4}
+	utils.AssertEqual(t, time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC), d.In(time.UTC))
+	utils.AssertEqual(t, true, d.In(ny).Equal(time.Date(2025, 7, 4, 4, 0, 0, 0, time.UTC)))
+
+	// Midnight does not exist in Santiago on 2025-09-07; the day starts at 01:00.
+	spring := gotime.Date{Year: 2025, Month: time.September, Day: 7}
+	utils.AssertEqual(t, true, spring.In(santiago).Equal(time.Date(2025, 9, 7, 4, 0, 0, 0, time.UTC)))
+	utils.AssertEqual(t, spring, gotime.DateOf(spring.In(santiago)))
+}
+
+func TestDateValidity(t *testing.T) {
+	tests := []struct {
+		date  gotime.Date
+		valid bool
+	}{
+		{gotime.Date{}, false},
+		{gotime.Date{Year: 2024, Month: time.February, Day: 29}, true},
+		{gotime.Date{Year: 2025, Month: time.February, Day: 29}, false},
+		{gotime.Date{Year: 2025, Month: 13, Day: 1}, false},
+		{gotime.Date{Year: 2025, Month: time.December, Day: 31}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.date.String(), func(t *testing.T) {
+			utils.AssertEqual(t, tc.valid, tc.date.IsValid())
+		})
+	}
+	utils.AssertEqual(t, true, gotime.Date{}.IsZero())
+}
+
+func TestDateArithmetic(t *testing.T) {
+	d := gotime.Date{Year: 2025, Month: time.January, Day: 31}
+	utils.AssertEqual(t, gotime.Date{Year: 2025, Month: time.February, Day: 1}, d.AddDays(1))
+	utils.AssertEqual(t, gotime.Date{Year: 2024, Month: time.December, Day: 31}, d.AddDays(-31))
+	utils.AssertEqual(t, gotime.Date{Year: 2025, Month: time.March, Day: 3}, d.AddMonths(1))
+	utils.AssertEqual(t, gotime.Date{Year: 2026, Month: time.January, Day: 31}, d.AddYears(1))
+	utils.AssertEqual(t, gotime.Date{Year: 2026, Month: time.March, Day: 3}, d.AddDate(1, 1, 0))
+
+	leap := gotime.Date{Year: 2024, Month: time.February, Day: 29}
+	utils.AssertEqual(t, gotime.Date{Year: 2025, Month: time.March, Day: 1}, leap.AddYears(1))
+
+	utils.AssertEqual(t, 366, gotime.Date{Year: 2025, Month: 1, Day: 1}.DaysSince(gotime.Date{Year: 2024, Month: 1, Day: 1}))
+	utils.AssertEqual(t, -1, gotime.Date{Year: 2024, Month: 12, Day: 31}.DaysSince(gotime.Date{Year: 2025, Month: 1, Day: 1}))
+}
+
+func TestDateComparison(t *testing.T) {
+	a := gotime.Date{Year: 2025, Month: time.March, Day: 10}
+	b := gotime.Date{Year: 2025, Month: time.April, Day: 1}
+	c := gotime.Date{Year: 2024, Month: time.December, Day: 31}
+
+	utils.AssertEqual(t, true, a.Before(b))
+	utils.AssertEqual(t, false, b.Before(a))
+	utils.AssertEqual(t, true, a.After(c))
+	utils.AssertEqual(t, -1, a.Compare(b))
+	utils.AssertEqual(t, 1, a.Compare(c))
+	utils.AssertEqual(t, 0, a.Compare(a))
+
+	utils.AssertEqual(t, time.Friday, gotime.Date{Year: 2025, Month: time.July, Day: 4}.Weekday())
+	utils.AssertEqual(t, 185, gotime.Date{Year: 2025, Month: time.July, Day: 4}.YearDay())
+}
+
+func TestDateFormatAndParse(t *testing.T) {
+	d := gotime.Date{Year: 2025, Month: time.July, Day: 4}
+	utils.AssertEqual(t, "2025-07-04", d.String())
+	utils.AssertEqual(t, "2025-07-04", d.Format(""))
+	utils.AssertEqual(t, "Friday, July 4th 2025", d.Format("wwww, mmmm dt yyyy"))
+	utils.AssertEqual(t, "0000-00-00", gotime.Date{}.String())
+
+	parsed, err := gotime.ParseDate("dd/mm/yyyy", "04/07/2025")
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, d, parsed)
+
+	parsed, err = gotime.ParseDate("", "2025-07-04")
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, d, parsed)
+
+	// A time of day in the value is dropped, not shifted across zones.
+	parsed, err = gotime.ParseDate("yyyy-mm-ddThhhh:ii:ssooo", "2025-07-04T23:30:00-05:00")
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, d, parsed)
+
+	_, err = gotime.ParseDate("yyyy-mm-dd", "2025-13-01")
+	utils.AssertEqual(t, true, err != nil)
+}
+
+func TestDateJSON(t *testing.T) {
+	type person struct {
+		Name     string      `json:"name"`
+		Birthday gotime.Date `json:"birthday"`
+	}
+
+	data, err := json.Marshal(person{"Ada", gotime.Date{Year: 1815, Month: time.December, Day: 10}})
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, `{"name":"Ada","birthday":"1815-12-10"}`, string(data))
+
+	data, err = json.Marshal(person{Name: "Nobody"})
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, `{"name":"Nobody","birthday":null}`, string(data))
+
+	var p person
+	utils.AssertEqual(t, nil, json.Unmarshal([]byte(`{"birthday":"2000-02-29"}`), &p))
+	utils.AssertEqual(t, gotime.Date{Year: 2000, Month: time.February, Day: 29}, p.Birthday)
+
+	utils.AssertEqual(t, nil, json.Unmarshal([]byte(`{"birthday":null}`), &p))
+	utils.AssertEqual(t, gotime.Date{}, p.Birthday)
+
+	utils.AssertEqual(t, true, json.Unmarshal([]byte(`{"birthday":"2001-02-29"}`), &p) != nil)
+	utils.AssertEqual(t, true, json.Unmarshal([]byte(`{"birthday":20010228}`), &p) != nil)
+
+	// Dates work as map keys through encoding.TextMarshaler.
+	data, err = json.Marshal(map[gotime.Date]string{{Year: 2025, Month: time.December, Day: 25}: "Christmas"})
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, `{"2025-12-25":"Christmas"}`, string(data))
+}
+
+func TestDateZeroRoundTrip(t *testing.T) {
+	text, err := gotime.Date{}.MarshalText()
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, "", string(text))
+
+	d := gotime.Date{Year: 2025, Month: time.July, Day: 4}
+	utils.AssertEqual(t, nil, d.UnmarshalText(text))
+	utils.AssertEqual(t, gotime.Date{}, d)
+
+	d = gotime.Date{Year: 2025, Month: time.July, Day: 4}
+	utils.AssertEqual(t, nil, d.UnmarshalText([]byte("0000-00-00")))
+	utils.AssertEqual(t, gotime.Date{}, d)
+
+	type event struct {
+		On gotime.Date `json:"on"`
+	}
+	data, err := json.Marshal(event{})
+	utils.AssertEqual(t, nil, err)
+	e := event{On: gotime.Date{Year: 2025, Month: time.July, Day: 4}}
+	utils.AssertEqual(t, nil, json.Unmarshal(data, &e))
+	utils.AssertEqual(t, gotime.Date{}, e.On)
+
+	data, err = json.Marshal(map[gotime.Date]string{{}: "unknown"})
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, `{"":"unknown"}`, string(data))
+	var m map[gotime.Date]string
+	utils.AssertEqual(t, nil, json.Unmarshal(data, &m))
+	utils.AssertEqual(t, "unknown", m[gotime.Date{}])
+}
+
+func TestDateSQL(t *testing.T) {
+	d := gotime.Date{Year: 2025, Month: time.July, Day: 4}
+
+	v, err := d.Value()
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, "2025-07-04", v)
+
+	v, err = gotime.Date{}.Value()
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, nil, v)
+
+	tests := []struct {
+		name string
+		src  interface{}
+		want gotime.Date
+	}{
+		{"nil", nil, gotime.Date{}},
+		{"string", "2025-07-04", d},
+		{"bytes", []byte("2025-07-04"), d},
+		{"timestamp text", "2025-07-04 00:00:00", d},
+		{"RFC3339 text", "2025-07-04T00:00:00Z", d},
+		{"time", time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC), d},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := gotime.Date{Year: 1, Month: 1, Day: 1}
+			utils.AssertEqual(t, nil, got.Scan(tc.src))
+			utils.AssertEqual(t, tc.want, got)
+		})
+	}
+
+	var got gotime.Date
+	err = got.Scan(42)
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrUnsupportedScanType))
+}
+
+func ExampleDate() {
+	birthday := gotime.Date{Year: 1990, Month: time.May, Day: 15}
+	fmt.Println(birthday, birthday.Weekday())
+	fmt.Println(birthday.AddYears(35).Format("mmmm dt, yyyy"))
+	// Output:
+	// 1990-05-15 Tuesday
+	// May 15th, 2025
+}
@@ -0,0 +1,283 @@
+package gotime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DurationUnit is a unit used by DurationInWordsWithOptions.
+//
+// Months and years have no fixed length; for formatting durations a month is
+// counted as 30 days and a year as 365 days.
+type DurationUnit int
+
+const (
+	// UnitMillisecond is a millisecond.
+	UnitMillisecond DurationUnit = iota + 1
+	// UnitSecond is a second.
+	UnitSecond
+	// UnitMinute is a minute.
+	UnitMinute
+	// UnitHour is an hour.
+	UnitHour
+	// UnitDay is 24 hours.
+	UnitDay
+	// UnitWeek is 7 days.
+	UnitWeek
+	// UnitMonth is 30 days.
+	UnitMonth
+	// UnitYear is 365 days.
+	UnitYear
+)
+
+// durationUnits describes every DurationUnit, indexed by the unit.
+var durationUnits = [...]struct {
+	length      time.Duration
+	long, longs string
+	short       string
+}{
+	UnitMillisecond: {time.Millisecond, "millisecond", "milliseconds", "ms"},
+	UnitSecond:      {time.Second, "second", "seconds", "s"},
+	UnitMinute:      {time.Minute, "minute", "minutes", "m"},
+	UnitHour:        {time.Hour, "hour", "hours", "h"},
+	UnitDay:         {24 * time.Hour, "day", "days", "d"},
+	UnitWeek:        {7 * 24 * time.Hour, "week", "weeks", "w"},
+	UnitMonth:       {30 * 24 * time.Hour, "month", "months", "mo"},
+	UnitYear:        {365 * 24 * time.Hour, "year", "years", "y"},
+}
+
+// DurationRounding selects how the part of a duration below the last unit shown
+// is handled.
+type DurationRounding int
+
+const (
+	// RoundDown drops the remainder, so 1h59m shown in hours is "1 hour".
+	RoundDown DurationRounding = iota
+	// RoundNearest rounds half away from zero, so 1h30m shown in hours is "2 hours".
+	RoundNearest
+	// RoundUp rounds any remainder up, so 1h1m shown in hours is "2 hours".
+	RoundUp
+)
+
+// DurationStyle selects how units are written.
+type DurationStyle int
+
+const (
+	// StyleLong writes full unit names: "2 days 3 hours".
+	StyleLong DurationStyle = iota
+	// StyleShort writes abbreviated units: "2d 3h".
+	StyleShort
+	// StyleColon writes a clock like "51:03:00" (hours, minutes and seconds,
+	// with a ".000" millisecond fraction when the smallest unit is milliseconds).
+	// Larger units are folded into the hours, and MaxUnits and Conjunction are
+	// ignored.
+	StyleColon
+)
+
+// Conjunction selects how the units are joined.
+type Conjunction int
+
+const (
+	// ConjunctionNone separates units with spaces: "2 days 3 hours 4 minutes".
+	ConjunctionNone Conjunction = iota
+	// ConjunctionComma separates units with commas: "2 days, 3 hours, 4 minutes".
+	ConjunctionComma
+	// ConjunctionAnd separates units with commas and "and" before the last one:
+	// "2 days, 3 hours and 4 minutes".
+	ConjunctionAnd
+)
+
+// DurationInWordsOptions configures DurationInWordsWithOptions. The zero value
+// writes every non-zero unit from days down to seconds, dropping any remainder.
+type DurationInWordsOptions struct {
+	// MaxUnits limits the number of units written. 0 means no limit.
+	MaxUnits int
+
+	// SmallestUnit and LargestUnit bound the units used. They default to
+	// UnitSecond and UnitDay.
+	SmallestUnit DurationUnit
+	LargestUnit  DurationUnit
+
+	// Rounding decides what happens to the part below the last unit written.
+	Rounding DurationRounding
+
+	// Style selects long, short or colon output.
+	Style DurationStyle
+
+	// Conjunction selects how the units are joined.
+	Conjunction Conjunction
+
+	// KeepZeroUnits writes zero units between the first and the last unit
+	// written, as in "2 days 0 hours 5 minutes", instead of skipping them.
+	KeepZeroUnits bool
+}
+
+// DurationInWordsWithOptions returns a human-readable representation of a
+// duration, like DurationInWords, with control over the units, rounding and
+// style. ParseHumanDuration parses the long and short styles back when no unit
+// larger than UnitWeek is used; ParseHumanPeriod also reads months and years.
+//
+// Example:
+//
+//	d := 51*time.Hour + 3*time.Minute
+//	gotime.DurationInWordsWithOptions(d, gotime.DurationInWordsOptions{})
+//	// "2 days 3 hours 3 minutes"
+//	gotime.DurationInWordsWithOptions(d, gotime.DurationInWordsOptions{Style: gotime.StyleShort, MaxUnits: 2})
+//	// "2d 3h"
+//	gotime.DurationInWordsWithOptions(d, gotime.DurationInWordsOptions{Style: gotime.StyleColon})
+//	// "51:03:00"
+//	gotime.DurationInWordsWithOptions(d, gotime.DurationInWordsOptions{MaxUnits: 2, Conjunction: gotime.ConjunctionAnd})
+//	// "2 days and 3 hours"
+func DurationInWordsWithOptions(d time.Duration, opts DurationInWordsOptions) string {
+	smallest, largest := opts.SmallestUnit, opts.LargestUnit
+	if smallest < UnitMillisecond || smallest > UnitYear {
+		smallest = UnitSecond
+	}
+	if largest < UnitMillisecond || largest > UnitYear {
+		largest = UnitDay
+	}
+	if largest < smallest {
+		largest = smallest
+	}
+
+	negative := d < 0
+	if negative {
+		if d == -1<<63 {
+			d++
+		}
+		d = -d
+	}
+	sign := ""
+	if negative {
+		sign = "-"
+	}
+
+	if opts.Style == StyleColon {
+		clock := colonDuration(d, smallest, opts.Rounding)
+		if strings.Trim(clock, "0:.") == "" {
+			// A negative duration that rounds to zero has no sign.
+			return clock
+		}
+		return sign + clock
+	}
+
+	parts, last := splitDuration(d, smallest, largest, opts)
+	if rest := d % durationUnits[last].length; len(parts) > 0 && roundsUp(rest, durationUnits[last].length, opts.Rounding) {
+		parts, _ = splitDuration(d-rest+durationUnits[last].length, smallest, largest, opts)
+	}
+
+	if len(parts) == 0 {
+		u := durationUnits[smallest]
+		if d == 0 {
+			return "0" + unitName(u.short, u.longs, opts.Style)
+		}
+		if roundsUp(d, u.length, opts.Rounding) {
+			return sign + "1" + unitName(u.short, u.long, opts.Style)
+		}
+		if opts.Style == StyleShort {
+			return "<1" + u.short
+		}
+		return "less than 1 " + u.long
+	}
+
+	words := make([]string, len(parts))
+	for i, p := range parts {
+		u := durationUnits[p.unit]
+		name := u.longs
+		if p.n == 1 {
+			name = u.long
+		}
+		words[i] = fmt.Sprintf("%d%s", p.n, unitName(u.short, name, opts.Style))
+	}
+	return sign + joinWords(words, opts.Conjunction)
+}
+
+// durationPart is a single unit of a split duration.
+type durationPart struct {
+	n    int64
+	unit DurationUnit
+}
+
+// splitDuration splits d into whole units from largest to smallest, starting at
+// the first non-zero unit. It returns the parts and the unit whose remainder was
+// dropped: the last unit written when MaxUnits was reached, else the smallest.
+func splitDuration(d time.Duration, smallest, largest DurationUnit, opts DurationInWordsOptions) ([]durationPart, DurationUnit) {
+	var parts []durationPart
+	last := smallest
+	for unit := largest; unit >= smallest; unit-- {
+		length := durationUnits[unit].length
+		n := int64(d / length)
+		d %= length
+		if n == 0 && (len(parts) == 0 || !opts.KeepZeroUnits) {
+			continue
+		}
+		parts = append(parts, durationPart{n, unit})
+		if opts.MaxUnits > 0 && len(parts) >= opts.MaxUnits {
+			last = unit
+			break
+		}
+	}
+	// Zero units kept after the last non-zero unit add nothing.
+	for len(parts) > 0 && parts[len(parts)-1].n == 0 {
+		parts = parts[:len(parts)-1]
+	}
+	return parts, last
+}
+
+// roundsUp reports whether the remainder rest of a unit of the given length
+// rounds up to a whole unit.
+func roundsUp(rest, length time.Duration, rounding DurationRounding) bool {
+	switch rounding {
+	case RoundUp:
+		return rest > 0
+	case RoundNearest:
+		return rest >= length-length/2
+	}
+	return false
+}
+
+// colonDuration formats d as hours:minutes:seconds, down to the smallest unit.
+func colonDuration(d time.Duration, smallest DurationUnit, rounding DurationRounding) string {
+	if smallest > UnitHour {
+		smallest = UnitHour
+	}
+	length := durationUnits[smallest].length
+	if rest := d % length; roundsUp(rest, length, rounding) {
+		d += length
+	}
+	d -= d % length
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d", d/time.Hour)
+	if smallest <= UnitMinute {
+		fmt.Fprintf(&b, ":%02d", d%time.Hour/time.Minute)
+	}
+	if smallest <= UnitSecond {
+		fmt.Fprintf(&b, ":%02d", d%time.Minute/time.Second)
+	}
+	if smallest == UnitMillisecond {
+		fmt.Fprintf(&b, ".%03d", d%time.Second/time.Millisecond)
+	}
+	return b.String()
+}
+
+// unitName returns the unit suffix for the given style, including the space
+// between the number and a long unit name.
+func unitName(short, long string, style DurationStyle) string {
+	if style == StyleShort {
+		return short
+	}
+	return " " + long
+}
+
+// joinWords joins the formatted units with the given conjunction.
+func joinWords(words []string, conjunction Conjunction) string {
+	switch {
+	case conjunction == ConjunctionComma:
+		return strings.Join(words, ", ")
+	case conjunction == ConjunctionAnd && len(words) > 1:
+		return strings.Join(words[:len(words)-1], ", ") + " and " + words[len(words)-1]
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,94 @@
+package gotime_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestDurationInWordsWithOptions(t *testing.T) {
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name string
+		d    time.Duration
+		opts gotime.DurationInWordsOptions
+		want string
+	}{
+		{"Defaults", 2*day + 3*time.Hour + 4*time.Minute + 5*time.Second, gotime.DurationInWordsOptions{}, "2 days 3 hours 4 minutes 5 seconds"},
+		{"Max units", 2*day + 3*time.Hour + 4*time.Minute, gotime.DurationInWordsOptions{MaxUnits: 2}, "2 days 3 hours"},
+		{"Skips zero units", 2*day + 5*time.Minute, gotime.DurationInWordsOptions{MaxUnits: 2}, "2 days 5 minutes"},
+		{"Keeps zero units", 2*day + 5*time.Minute, gotime.DurationInWordsOptions{KeepZeroUnits: true}, "2 days 0 hours 5 minutes"},
+		{"Keeps zero units within max", 2*day + 5*time.Minute, gotime.DurationInWordsOptions{KeepZeroUnits: true, MaxUnits: 2}, "2 days"},
+		{"Weeks", 10 * day, gotime.DurationInWordsOptions{LargestUnit: gotime.UnitWeek}, "1 week 3 days"},
+		{"Months and years", 400 * day, gotime.DurationInWordsOptions{LargestUnit: gotime.UnitYear}, "1 year 1 month 5 days"},
+		{"Largest hours", 2*day + 3*time.Hour, gotime.DurationInWordsOptions{LargestUnit: gotime.UnitHour}, "51 hours"},
+		{"Milliseconds", 1500 * time.Millisecond, gotime.DurationInWordsOptions{SmallestUnit: gotime.UnitMillisecond}, "1 second 500 milliseconds"},
+		{"Smallest minutes", 5*time.Minute + 50*time.Second, gotime.DurationInWordsOptions{SmallestUnit: gotime.UnitMinute}, "5 minutes"},
+		{"Round nearest", 5*time.Minute + 50*time.Second, gotime.DurationInWordsOptions{SmallestUnit: gotime.UnitMinute, Rounding: gotime.RoundNearest}, "6 minutes"},
+		{"Round nearest carries", time.Hour + 59*time.Minute + 45*time.Second, gotime.DurationInWordsOptions{MaxUnits: 2, Rounding: gotime.RoundNearest}, "2 hours"},
+		{"Round up", time.Hour + time.Second, gotime.DurationInWordsOptions{MaxUnits: 1, Rounding: gotime.RoundUp}, "2 hours"},
+		{"Round down exact", 2 * day, gotime.DurationInWordsOptions{Rounding: gotime.RoundUp}, "2 days"},
+		{"Round up below smallest", 20 * time.Millisecond, gotime.DurationInWordsOptions{Rounding: gotime.RoundUp}, "1 second"},
+		{"Less than", 20 * time.Millisecond, gotime.DurationInWordsOptions{}, "less than 1 second"},
+		{"Short", 2*day + 3*time.Hour, gotime.DurationInWordsOptions{Style: gotime.StyleShort}, "2d 3h"},
+		{"Short months", 45 * day, gotime.DurationInWordsOptions{Style: gotime.StyleShort, LargestUnit: gotime.UnitMonth}, "1mo 2w 1d"},
+		{"Short less than", time.Millisecond, gotime.DurationInWordsOptions{Style: gotime.StyleShort}, "<1s"},
+		{"Short zero", 0, gotime.DurationInWordsOptions{Style: gotime.StyleShort}, "0s"},
+		{"Colon", 2*day + 3*time.Hour + 3*time.Minute, gotime.DurationInWordsOptions{Style: gotime.StyleColon}, "51:03:00"},
+		{"Colon minutes", 90*time.Minute + 40*time.Second, gotime.DurationInWordsOptions{Style: gotime.StyleColon, SmallestUnit: gotime.UnitMinute, Rounding: gotime.RoundNearest}, "1:31"},
+		{"Colon milliseconds", 61*time.Second + 5*time.Millisecond, gotime.DurationInWordsOptions{Style: gotime.StyleColon, SmallestUnit: gotime.UnitMillisecond}, "0:01:01.005"},
+		{"Colon negative", -90 * time.Second, gotime.DurationInWordsOptions{Style: gotime.StyleColon}, "-0:01:30"},
+		{"Colon negative zero", -400 * time.Millisecond, gotime.DurationInWordsOptions{Style: gotime.StyleColon}, "0:00:00"},
+		{"And two", 2*day + 3*time.Hour, gotime.DurationInWordsOptions{Conjunction: gotime.ConjunctionAnd}, "2 days and 3 hours"},
+		{"And three", 2*day + 3*time.Hour + time.Minute, gotime.DurationInWordsOptions{Conjunction: gotime.ConjunctionAnd}, "2 days, 3 hours and 1 minute"},
+		{"Comma", 2*day + 3*time.Hour + time.Minute, gotime.DurationInWordsOptions{Conjunction: gotime.ConjunctionComma}, "2 days, 3 hours, 1 minute"},
+		{"Negative", -(3*time.Hour + 20*time.Minute), gotime.DurationInWordsOptions{Style: gotime.StyleShort}, "-3h 20m"},
+		{"Zero", 0, gotime.DurationInWordsOptions{SmallestUnit: gotime.UnitMinute}, "0 minutes"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			utils.AssertEqual(t, tc.want, gotime.DurationInWordsWithOptions(tc.d, tc.opts))
+		})
+	}
+}
+
+func TestDurationInWordsWithOptionsRoundTrip(t *testing.T) {
+	styles := []gotime.DurationInWordsOptions{
+		{},
+		{Style: gotime.StyleShort},
+		{Conjunction: gotime.ConjunctionAnd, LargestUnit: gotime.UnitWeek},
+		{Conjunction: gotime.ConjunctionComma, SmallestUnit: gotime.UnitMillisecond},
+	}
+	durations := []time.Duration{
+		time.Second,
+		90 * time.Minute,
+		26*time.Hour + 15*time.Second,
+		9*24*time.Hour + 7*time.Minute,
+		-(5 * time.Hour),
+	}
+	for _, opts := range styles {
+		for _, d := range durations {
+			words := gotime.DurationInWordsWithOptions(d, opts)
+			t.Run(words, func(t *testing.T) {
+				got, err := gotime.ParseHumanDuration(words)
+				utils.AssertEqual(t, nil, err)
+				utils.AssertEqual(t, d, got)
+			})
+		}
+	}
+}
+
+func ExampleDurationInWordsWithOptions() {
+	d := 51*time.Hour + 3*time.Minute
+	fmt.Println(gotime.DurationInWordsWithOptions(d, gotime.DurationInWordsOptions{Style: gotime.StyleShort, MaxUnits: 2}))
+	fmt.Println(gotime.DurationInWordsWithOptions(d, gotime.DurationInWordsOptions{Style: gotime.StyleColon}))
+	fmt.Println(gotime.DurationInWordsWithOptions(d, gotime.DurationInWordsOptions{Conjunction: gotime.ConjunctionAnd}))
+	// Output:
+	// 2d 3h
+	// 51:03:00
+	// 2 days, 3 hours and 3 minutes
+}
@@ -0,0 +1,369 @@
+package gotime
+
+import (
+	"time"
+
+	"github.com/maniartech/gotime/v2/internal/nites"
+)
+
+// FiscalPattern describes how a fiscal calendar divides its year into twelve periods.
+type FiscalPattern int
+
+const (
+	// FiscalMonths uses calendar months as fiscal periods. The fiscal year always
+	// starts on the first day of the configured start month.
+	FiscalMonths FiscalPattern = iota
+
+	// Fiscal445 is a 52/53-week retail calendar whose quarters are split into
+	// periods of 4, 4 and 5 weeks.
+	Fiscal445
+
+	// Fiscal454 is a 52/53-week retail calendar whose quarters are split into
+	// periods of 4, 5 and 4 weeks.
+	Fiscal454
+
+	// Fiscal544 is a 52/53-week retail calendar whose quarters are split into
+	// periods of 5, 4 and 4 weeks.
+	Fiscal544
+)
+
+// FiscalYearEnd selects which week-ending day closes a 52/53-week fiscal year.
+type FiscalYearEnd int
+
+const (
+	// FiscalEndLast ends the fiscal year on the last WeekEnd day of the month
+	// before the start month.
+	FiscalEndLast FiscalYearEnd = iota
+
+	// FiscalEndNearest ends the fiscal year on the WeekEnd day nearest to the
+	// last day of the month before the start month. The year may therefore end
+	// up to three days into the start month (the NRF retail calendar uses this rule).
+	FiscalEndNearest
+)
+
+// FiscalCalendar describes a fiscal year that does not necessarily follow the
+// calendar year. The zero value is a January-start calendar that matches the
+// regular calendar year and quarters.
+//
+// Fiscal years are labelled after the calendar year in which they end, so with
+// an April start, April 2025 to March 2026 is fiscal year 2026. Set
+// LabelByStartYear to label them after the year in which they start instead.
+//
+// Example:
+//
+//	fc := gotime.NewFiscalCalendar(time.April)
+//	fy := fc.FiscalYear(time.Date(2025, 7, 15, 0, 0, 0, 0, time.UTC))
+//	// fy: 2026
+//	q := fc.FiscalQuarter(time.Date(2025, 7, 15, 0, 0, 0, 0, time.UTC))
+//	// q: 2
+type FiscalCalendar struct {
+	// StartMonth is the month in which the fiscal year starts. Zero means January.
+	StartMonth time.Month
+
+	// Pattern selects calendar-month periods or a 4-4-5 style retail pattern.
+	Pattern FiscalPattern
+
+	// WeekEnd is the last day of every fiscal week. It is only used by the
+	// retail patterns.
+	WeekEnd time.Weekday
+
+	// EndRule selects how a retail fiscal year ends. It is only used by the
+	// retail patterns.
+	EndRule FiscalYearEnd
+
+	// LabelByStartYear labels fiscal years after the calendar year in which
+	// they start rather than the year in which they end.
+	LabelByStartYear bool
+}
+
+// NewFiscalCalendar returns a fiscal calendar that starts on the first day of
+// startMonth and uses calendar months as periods.
+//
+// Example:
+//
+//	fc := gotime.NewFiscalCalendar(time.October) // US federal fiscal year
+//	start := fc.YearStart(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+//	// start: 2024-10-01 00:00:00 +0000 UTC
+func NewFiscalCalendar(startMonth time.Month) FiscalCalendar {
+	return FiscalCalendar{StartMonth: startMonth}
+}
+
+// NewRetailCalendar returns a 52/53-week fiscal calendar. Every fiscal week ends
+// on weekEnd and the year ends according to the given rule around the end of the
+// month before startMonth. When a year has 53 weeks, the extra week is added to
+// the last period.
+//
+// Example:
+//
+//	// NRF calendar: weeks end on Saturday, year ends on the Saturday nearest January 31st.
+//	fc := gotime.NewRetailCalendar(time.February, gotime.Fiscal445, time.Saturday, gotime.FiscalEndNearest)
+//	start := fc.YearStart(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+//	// start: 2024-02-04 00:00:00 +0000 UTC
+func NewRetailCalendar(startMonth time.Month, pattern FiscalPattern, weekEnd time.Weekday, yearEnd FiscalYearEnd) FiscalCalendar {
+	return FiscalCalendar{
+		StartMonth: startMonth,
+		Pattern:    pattern,
+		WeekEnd:    weekEnd,
+		EndRule:    yearEnd,
+	}
+}
+
+// IsRetail reports whether the calendar uses 52/53-week years.
+func (fc FiscalCalendar) IsRetail() bool {
+	return fc.Pattern == Fiscal445 || fc.Pattern == Fiscal454 || fc.Pattern == Fiscal544
+}
+
+// FiscalYear returns the fiscal year label for the given time.
+// If no time is provided, it uses the current time.
+func (fc FiscalCalendar) FiscalYear(dt ...time.Time) int {
+	return fc.resolve(dt...).label
+}
+
+// FiscalQuarter returns the fiscal quarter (1-4) for the given time.
+// If no time is provided, it uses the current time.
+func (fc FiscalCalendar) FiscalQuarter(dt ...time.Time) int {
+	return (fc.resolve(dt...).period-1)/3 + 1
+}
+
+// FiscalPeriod returns the fiscal period (1-12) for the given time. Periods are
+// calendar months for FiscalMonths and 4 or 5 week blocks for the retail patterns.
+// If no time is provided, it uses the current time.
+func (fc FiscalCalendar) FiscalPeriod(dt ...time.Time) int {
+	return fc.resolve(dt...).period
+}
+
+// FiscalWeek returns the week (1-53) within the fiscal year for the given time.
+// For FiscalMonths calendars weeks are counted in seven day blocks from the first
+// day of the fiscal year. If no time is provided, it uses the current time.
+func (fc FiscalCalendar) FiscalWeek(dt ...time.Time) int {
+	f := fc.resolve(dt...)
+	return daysBetweenDates(f.yearStart, f.date)/7 + 1
+}
+
+// Format formats dt with a NITES layout like gotime.Format, except that the
+// fiscal year tokens "fyyyy" and "fyy" and the quarter tokens "q" and "qq" give
+// the fiscal year and quarter of dt.
+//
+// Example:
+//
+//	fc := gotime.NewFiscalCalendar(time.April)
+//	fc.Format(time.Date(2025, 7, 15, 0, 0, 0, 0, time.UTC), "'FY'fyy qq")
+//	// "FY26 Q2"
+func (fc FiscalCalendar) Format(dt time.Time, layout string) string {
+	f := fc.resolve(dt)
+	return nites.FormatFiscal(dt, layout, f.label, (f.period-1)/3+1)
+}
+
+// YearStart returns the first day of the fiscal year for the given time.
+// If no time is provided, it uses the current time.
+func (fc FiscalCalendar) YearStart(dt ...time.Time) time.Time {
+	f := fc.resolve(dt...)
+	return startOfDate(f.yearStart, f.loc)
+}
+
+// YearEnd returns the last day and last second of the fiscal year for the given time.
+// If no time is provided, it uses the current time.
+func (fc FiscalCalendar) YearEnd(dt ...time.Time) time.Time {
+	f := fc.resolve(dt...)
+	return endOfDate(f.yearEnd, f.loc)
+}
+
+// QuarterStart returns the first day of the fiscal quarter for the given time.
+// If no time is provided, it uses the current time.
+func (fc FiscalCalendar) QuarterStart(dt ...time.Time) time.Time {
+	f := fc.resolve(dt...)
+	start, _ := fc.periodBounds(f, (f.period-1)/3*3+1)
+	return startOfDate(start, f.loc)
+}
+
+// QuarterEnd returns the last day and last second of the fiscal quarter for the given time.
+// If no time is provided, it uses the current time.
+func (fc FiscalCalendar) QuarterEnd(dt ...time.Time) time.Time {
+	f := fc.resolve(dt...)
+	_, end := fc.periodBounds(f, (f.period-1)/3*3+3)
+	return endOfDate(end, f.loc)
+}
+
+// PeriodStart returns the first day of the fiscal period for the given time.
+// If no time is provided, it uses the current time.
+func (fc FiscalCalendar) PeriodStart(dt ...time.Time) time.Time {
+	f := fc.resolve(dt...)
+	start, _ := fc.periodBounds(f, f.period)
+	return startOfDate(start, f.loc)
+}
+
+// PeriodEnd returns the last day and last second of the fiscal period for the given time.
+// If no time is provided, it uses the current time.
+func (fc FiscalCalendar) PeriodEnd(dt ...time.Time) time.Time {
+	f := fc.resolve(dt...)
+	_, end := fc.periodBounds(f, f.period)
+	return endOfDate(end, f.loc)
+}
+
+// WeeksInYear returns the number of weeks (52 or 53) in the given fiscal year of
+// a retail calendar. For FiscalMonths calendars it returns 52.
+func (fc FiscalCalendar) WeeksInYear(fiscalYear int) int {
+	if !fc.IsRetail() {
+		return 52
+	}
+	startYear := fc.startYearOf(fiscalYear)
+	days := daysBetweenDates(fc.retailYearStart(startYear), fc.retailYearStart(startYear+1))
+	return days / 7
+}
+
+// fiscalDate holds the resolved fiscal position of a time. Dates are kept as UTC
+// midnights so that the arithmetic is not affected by DST transitions.
+type fiscalDate struct {
+	loc       *time.Location
+	date      time.Time
+	startYear int
+	label     int
+	yearStart time.Time
+	yearEnd   time.Time
+	period    int
+}
+
+func (fc FiscalCalendar) startMonth() time.Month {
+	if fc.StartMonth < time.January || fc.StartMonth > time.December {
+		return time.January
+	}
+	return fc.StartMonth
+}
+
+// labelOf returns the fiscal year label of a fiscal year that starts in startYear.
+func (fc FiscalCalendar) labelOf(startYear int) int {
+	if fc.startMonth() == time.January || fc.LabelByStartYear {
+		return startYear
+	}
+	return startYear + 1
+}
+
+// startYearOf is the inverse of labelOf.
+func (fc FiscalCalendar) startYearOf(label int) int {
+	if fc.startMonth() == time.January || fc.LabelByStartYear {
+		return label
+	}
+	return label - 1
+}
+
+func (fc FiscalCalendar) resolve(dt ...time.Time) fiscalDate {
+	var t time.Time
+	if len(dt) > 0 {
+		t = dt[0]
+	} else {
+		t = Now()
+	}
+
+	f := fiscalDate{
+		loc:  t.Location(),
+		date: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC),
+	}
+
+	startMonth := fc.startMonth()
+	f.startYear = t.Year()
+	if t.Month() < startMonth {
+		f.startYear--
+	}
+
+	if !fc.IsRetail() {
+		f.yearStart = time.Date(f.startYear, startMonth, 1, 0, 0, 0, 0, time.UTC)
+		f.yearEnd = f.yearStart.AddDate(1, 0, -1)
+		f.period = (int(t.Month())-int(startMonth)+12)%12 + 1
+		f.label = fc.labelOf(f.startYear)
+		return f
+	}
+
+	// A retail year may start a few days before or after the start month, so
+	// step to the neighbouring year when the date falls outside the estimate.
+	f.yearStart = fc.retailYearStart(f.startYear)
+	next := fc.retailYearStart(f.startYear + 1)
+	if f.date.Before(f.yearStart) {
+		f.startYear--
+		next = f.yearStart
+		f.yearStart = fc.retailYearStart(f.startYear)
+	} else if !f.date.Before(next) {
+		f.startYear++
+		f.yearStart = next
+		next = fc.retailYearStart(f.startYear + 1)
+	}
+	f.yearEnd = next.AddDate(0, 0, -1)
+	f.label = fc.labelOf(f.startYear)
+
+	week := daysBetweenDates(f.yearStart, f.date) / 7
+	f.period = 12
+	weeks := 0
+	for p := 1; p <= 12; p++ {
+		weeks += fc.weeksInPeriod(p)
+		if week < weeks {
+			f.period = p
+			break
+		}
+	}
+	return f
+}
+
+// retailYearStart returns the first day of the retail fiscal year that nominally
+// starts in the start month of startYear.
+func (fc FiscalCalendar) retailYearStart(startYear int) time.Time {
+	// The previous fiscal year ends in the month before the start month.
+	endMonthFirst := time.Date(startYear, fc.startMonth(), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := endMonthFirst.AddDate(0, 0, -1)
+
+	back := (int(lastDay.Weekday()) - int(fc.WeekEnd) + 7) % 7
+	end := lastDay.AddDate(0, 0, -back)
+	if fc.EndRule == FiscalEndNearest && back > 3 {
+		end = end.AddDate(0, 0, 7)
+	}
+	return end.AddDate(0, 0, 1)
+}
+
+// weeksInPeriod returns the number of weeks in a retail period, ignoring the
+// extra week of 53-week years.
+func (fc FiscalCalendar) weeksInPeriod(period int) int {
+	var pattern [3]int
+	switch fc.Pattern {
+	case Fiscal454:
+		pattern = [3]int{4, 5, 4}
+	case Fiscal544:
+		pattern = [3]int{5, 4, 4}
+	default:
+		pattern = [3]int{4, 4, 5}
+	}
+	return pattern[(period-1)%3]
+}
+
+// periodBounds returns the first and last date of the given period in the
+// fiscal year of f.
+func (fc FiscalCalendar) periodBounds(f fiscalDate, period int) (start, end time.Time) {
+	if !fc.IsRetail() {
+		start = f.yearStart.AddDate(0, period-1, 0)
+		return start, start.AddDate(0, 1, -1)
+	}
+
+	weeks := 0
+	for p := 1; p < period; p++ {
+		weeks += fc.weeksInPeriod(p)
+	}
+	start = f.yearStart.AddDate(0, 0, weeks*7)
+	if period == 12 {
+		// The last period absorbs the 53rd week when there is one.
+		return start, f.yearEnd
+	}
+	return start, start.AddDate(0, 0, fc.weeksInPeriod(period)*7-1)
+}
+
+// daysBetweenDates returns the number of whole days from a to b, where both are
+// UTC midnights.
+func daysBetweenDates(a, b time.Time) int {
+	return int(b.Sub(a).Hours() / 24)
+}
+
+// startOfDate returns the start of the calendar day d (a UTC midnight) in loc.
+func startOfDate(d time.Time, loc *time.Location) time.Time {
+	return startOfDay(d.Year(), d.Month(), d.Day(), loc)
+}
+
+// endOfDate returns the last nanosecond of the calendar day d (a UTC midnight) in loc.
+func endOfDate(d time.Time, loc *time.Location) time.Time {
+	return endOfDay(d.Year(), d.Month(), d.Day(), loc)
+}
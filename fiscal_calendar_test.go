@@ -0,0 +1,156 @@
+package gotime_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestFiscalCalendarZeroValueMatchesCalendar(t *testing.T) {
+	var fc gotime.FiscalCalendar
+	dates := []time.Time{
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 5, 15, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, 12, 31, 23, 59, 0, 0, time.UTC),
+	}
+	for _, d := range dates {
+		utils.AssertEqual(t, d.Year(), fc.FiscalYear(d))
+		utils.AssertEqual(t, gotime.QuarterOfYear(d), fc.FiscalQuarter(d))
+		utils.AssertEqual(t, int(d.Month()), fc.FiscalPeriod(d))
+		utils.AssertEqual(t, gotime.QuarterStart(d), fc.QuarterStart(d))
+		utils.AssertEqual(t, gotime.QuarterEnd(d), fc.QuarterEnd(d))
+		utils.AssertEqual(t, gotime.YearStart(d), fc.YearStart(d))
+		utils.AssertEqual(t, gotime.YearEnd(d), fc.YearEnd(d))
+		utils.AssertEqual(t, gotime.MonthStart(d), fc.PeriodStart(d))
+		utils.AssertEqual(t, gotime.MonthEnd(d), fc.PeriodEnd(d))
+	}
+}
+
+func TestFiscalCalendarAprilStart(t *testing.T) {
+	fc := gotime.NewFiscalCalendar(time.April)
+
+	tests := []struct {
+		name    string
+		date    time.Time
+		year    int
+		quarter int
+		period  int
+	}{
+		{"First day", time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), 2026, 1, 1},
+		{"July", time.Date(2025, 7, 15, 0, 0, 0, 0, time.UTC), 2026, 2, 4},
+		{"December", time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), 2026, 3, 9},
+		{"January", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), 2026, 4, 10},
+		{"Last day", time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC), 2026, 4, 12},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			utils.AssertEqual(t, tc.year, fc.FiscalYear(tc.date))
+			utils.AssertEqual(t, tc.quarter, fc.FiscalQuarter(tc.date))
+			utils.AssertEqual(t, tc.period, fc.FiscalPeriod(tc.date))
+		})
+	}
+
+	d := time.Date(2026, 2, 10, 15, 0, 0, 0, time.UTC)
+	utils.AssertEqual(t, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), fc.YearStart(d))
+	utils.AssertEqual(t, time.Date(2026, 3, 31, 23, 59, 59, 999999999, time.UTC), fc.YearEnd(d))
+	utils.AssertEqual(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), fc.QuarterStart(d))
+	utils.AssertEqual(t, time.Date(2026, 3, 31, 23, 59, 59, 999999999, time.UTC), fc.QuarterEnd(d))
+	utils.AssertEqual(t, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), fc.PeriodStart(d))
+	utils.AssertEqual(t, time.Date(2026, 2, 28, 23, 59, 59, 999999999, time.UTC), fc.PeriodEnd(d))
+
+	fc.LabelByStartYear = true
+	utils.AssertEqual(t, 2025, fc.FiscalYear(d))
+}
+
+func TestFiscalCalendarRetailNRF(t *testing.T) {
+	fc := gotime.NewRetailCalendar(time.February, gotime.Fiscal445, time.Saturday, gotime.FiscalEndNearest)
+	fc.LabelByStartYear = true
+
+	// Fiscal 2023 was a 53-week year: 2023-01-29 to 2024-02-03.
+	utils.AssertEqual(t, 53, fc.WeeksInYear(2023))
+	utils.AssertEqual(t, 52, fc.WeeksInYear(2024))
+
+	d := time.Date(2024, 2, 3, 10, 0, 0, 0, time.UTC)
+	utils.AssertEqual(t, 2023, fc.FiscalYear(d))
+	utils.AssertEqual(t, time.Date(2023, 1, 29, 0, 0, 0, 0, time.UTC), fc.YearStart(d))
+	utils.AssertEqual(t, time.Date(2024, 2, 3, 23, 59, 59, 999999999, time.UTC), fc.YearEnd(d))
+	utils.AssertEqual(t, 12, fc.FiscalPeriod(d))
+	utils.AssertEqual(t, 53, fc.FiscalWeek(d))
+	// The last period absorbs the 53rd week.
+	utils.AssertEqual(t, time.Date(2023, 12, 24, 0, 0, 0, 0, time.UTC), fc.PeriodStart(d))
+	utils.AssertEqual(t, time.Date(2023, 10, 29, 0, 0, 0, 0, time.UTC), fc.QuarterStart(d))
+
+	d = time.Date(2024, 2, 4, 0, 0, 0, 0, time.UTC)
+	utils.AssertEqual(t, 2024, fc.FiscalYear(d))
+	utils.AssertEqual(t, 1, fc.FiscalPeriod(d))
+	utils.AssertEqual(t, 1, fc.FiscalWeek(d))
+	utils.AssertEqual(t, time.Date(2024, 3, 2, 23, 59, 59, 999999999, time.UTC), fc.PeriodEnd(d))
+	utils.AssertEqual(t, time.Date(2024, 5, 4, 23, 59, 59, 999999999, time.UTC), fc.QuarterEnd(d))
+}
+
+func TestFiscalCalendarRetailPatterns(t *testing.T) {
+	tests := []struct {
+		pattern gotime.FiscalPattern
+		weeks   [3]int
+	}{
+		{gotime.Fiscal445, [3]int{4, 4, 5}},
+		{gotime.Fiscal454, [3]int{4, 5, 4}},
+		{gotime.Fiscal544, [3]int{5, 4, 4}},
+	}
+	for _, tc := range tests {
+		fc := gotime.NewRetailCalendar(time.January, tc.pattern, time.Saturday, gotime.FiscalEndLast)
+		d := fc.YearStart(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
+		for p := 0; p < 3; p++ {
+			utils.AssertEqual(t, p+1, fc.FiscalPeriod(d))
+			end := fc.PeriodEnd(d)
+			utils.AssertEqual(t, tc.weeks[p]*7, gotime.DaysBetween(d, end)+1)
+			d = end.Add(time.Nanosecond)
+		}
+		utils.AssertEqual(t, 2, fc.FiscalQuarter(d))
+	}
+}
+
+func TestFiscalCalendarRetailLastRule(t *testing.T) {
+	fc := gotime.NewRetailCalendar(time.January, gotime.Fiscal445, time.Saturday, gotime.FiscalEndLast)
+
+	// The last Saturday of December 2024 is the 28th, so fiscal 2025 starts on the 29th.
+	start := fc.YearStart(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+	utils.AssertEqual(t, time.Date(2024, 12, 29, 0, 0, 0, 0, time.UTC), start)
+	utils.AssertEqual(t, 2025, fc.FiscalYear(time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)))
+	utils.AssertEqual(t, time.Saturday, fc.YearEnd(start).Weekday())
+}
+
+func TestFiscalCalendarKeepsLocation(t *testing.T) {
+	loc := time.FixedZone("IST", 5*3600+1800)
+	fc := gotime.NewFiscalCalendar(time.April)
+	start := fc.YearStart(time.Date(2025, 5, 1, 1, 0, 0, 0, loc))
+	utils.AssertEqual(t, time.Date(2025, 4, 1, 0, 0, 0, 0, loc), start)
+	utils.AssertEqual(t, loc, start.Location())
+}
+
+func TestFiscalCalendarFormat(t *testing.T) {
+	d := time.Date(2025, 7, 15, 0, 0, 0, 0, time.UTC)
+
+	fc := gotime.NewFiscalCalendar(time.April)
+	utils.AssertEqual(t, "FY26 Q2", fc.Format(d, "'FY'fyy qq"))
+	utils.AssertEqual(t, "2026-2 15/07/2025", fc.Format(d, "fyyyy-q dd/mm/yyyy"))
+
+	// The zero value gives the calendar year and quarter.
+	var cal gotime.FiscalCalendar
+	utils.AssertEqual(t, "FY2025 Q3", cal.Format(d, "'FY'fyyyy qq"))
+	utils.AssertEqual(t, gotime.Format(d, "fyyyy qq"), cal.Format(d, "fyyyy qq"))
+
+	retail := gotime.NewRetailCalendar(time.February, gotime.Fiscal445, time.Saturday, gotime.FiscalEndNearest)
+	utils.AssertEqual(t, "FY2026 Q4", retail.Format(time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC), "'FY'fyyyy qq"))
+}
+
+func ExampleFiscalCalendar() {
+	// Fiscal year starting in April, labelled by the year in which it ends
+	fc := gotime.NewFiscalCalendar(time.April)
+	d := time.Date(2025, 7, 15, 0, 0, 0, 0, time.UTC)
+	fmt.Println(fc.FiscalYear(d), fc.FiscalQuarter(d), fc.FiscalPeriod(d))
+	// Output: 2026 2 4
+}
@@ -10,7 +10,12 @@ import (
 // If layout is empty, RFC3339 format is used by default.
 //
 // The layout uses NITES (Natural and Intuitive Time Expression Syntax) format
-// specifiers like "yyyy-mm-dd" instead of Go's reference time format.
+// specifiers like "yyyy-mm-dd" instead of Go's reference time format. Text in
+// single quotes or square brackets is literal, as is a character after a
+// backslash; two single quotes stand for one. Quotes and brackets were
+// ordinary characters before, so "yyyy'mm'dd" now prints "2025mm04" and
+// "[yyyy-mm-dd]" prints "yyyy-mm-dd"; double the quotes or escape them, as in
+// `yyyy\'mm\'dd` and `\[yyyy-mm-dd\]`, to print them around the fields.
 //
 // Example:
 //
@@ -19,6 +24,15 @@ import (
 //
 //	formatted = gotime.Format(time.Now(), "mmmm dd, yyyy")
 //	// formatted: "July 08, 2025"
+//
+//	formatted = gotime.Format(time.Now(), "wwww 'at' hhhh 'o''clock'")
+//	// formatted: "Tuesday at 15 o'clock"
+//
+//	formatted = gotime.Format(time.Now(), `\[yyyy-mm-dd hhhh:ii:ss\]`)
+//	// formatted: "[2025-07-08 15:04:05]"
+//
+//	formatted = gotime.Format(time.Now(), "yyyy''mm''dd")
+//	// formatted: "2025'07'08"
 func Format(dt time.Time, layout string) string {
 	if layout == "" {
 		// Layout is RFC3339 by default
@@ -1,6 +1,7 @@
 package gotime_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -75,3 +76,16 @@ func BenchmarkFormat(b *testing.B) {
 		gotime.Format(date, "yyyy/mm/dd")
 	}
 }
+
+func ExampleFormat_quotedLiterals() {
+	date := time.Date(2025, 7, 4, 15, 0, 0, 0, time.UTC)
+	fmt.Println(gotime.Format(date, "wwww 'at' hhhh 'o''clock'"))
+	fmt.Println(gotime.Format(date, "[Day] ddd, dt 'of' mmmm"))
+
+	parsed, _ := gotime.Parse("[Week of] mmm d, yyyy", "Week of Jul 4, 2025")
+	fmt.Println(parsed)
+	// Output:
+	// Friday at 15 o'clock
+	// Day 185, 4th of July
+	// 2025-07-04 00:00:00 +0000 UTC
+}
@@ -0,0 +1,233 @@
+package gotime
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maniartech/gotime/v2/internal/nites"
+)
+
+// Errors returned when decoding a Formatted value whose options forbid it.
+var (
+	ErrNullValue  = errors.New("null value is not allowed")
+	ErrEmptyValue = errors.New("empty value is not allowed")
+)
+
+// Layout supplies the NITES layout used by Formatted. It is implemented by
+// small empty struct types so that the layout becomes part of the field type:
+//
+//	type DMY struct{}
+//
+//	func (DMY) Layout() string { return "dd/mm/yyyy" }
+//
+//	type Invoice struct {
+//		Due gotime.Formatted[DMY] `json:"due"`
+//	}
+//
+// A layout type can also implement LayoutOptions to change how zero, null and
+// empty values are handled.
+type Layout interface {
+	Layout() string
+}
+
+// LayoutOptions is optionally implemented by a Layout to configure how
+// Formatted encodes and decodes values.
+type LayoutOptions interface {
+	Options() FormattedOptions
+}
+
+// ZeroEncoding selects how Formatted encodes the zero time.
+type ZeroEncoding int
+
+const (
+	// ZeroAsNull encodes the zero time as JSON null, SQL NULL and empty text.
+	ZeroAsNull ZeroEncoding = iota
+
+	// ZeroAsEmpty encodes the zero time as an empty string everywhere.
+	ZeroAsEmpty
+
+	// ZeroAsValue formats the zero time with the layout like any other time.
+	ZeroAsValue
+)
+
+// FormattedOptions configures the encoding of a Formatted value. The zero value
+// encodes the zero time as null and decodes null and empty strings to the zero
+// time, using UTC for values without zone information.
+type FormattedOptions struct {
+	// Location is used for values that carry no zone information. A nil
+	// Location means UTC.
+	Location *time.Location
+
+	// Zero selects how the zero time is encoded.
+	Zero ZeroEncoding
+
+	// RejectNull makes decoding JSON null or SQL NULL fail with ErrNullValue.
+	RejectNull bool
+
+	// RejectEmpty makes decoding an empty string fail with ErrEmptyValue.
+	RejectEmpty bool
+}
+
+// ISODateLayout is a Layout for "yyyy-mm-dd" dates.
+type ISODateLayout struct{}
+
+// Layout returns "yyyy-mm-dd".
+func (ISODateLayout) Layout() string { return "yyyy-mm-dd" }
+
+// RFC3339Layout is a Layout for RFC 3339 timestamps such as
+// "2025-07-04T09:30:00+05:30".
+type RFC3339Layout struct{}
+
+// Layout returns the RFC 3339 layout.
+func (RFC3339Layout) Layout() string { return time.RFC3339 }
+
+// Formatted is a time.Time that encodes itself to JSON, text and SQL using the
+// NITES layout of L instead of RFC 3339. It embeds time.Time, so all of its
+// methods are available.
+//
+// Example:
+//
+//	type DMY struct{}
+//
+//	func (DMY) Layout() string { return "dd/mm/yyyy" }
+//
+//	var due gotime.Formatted[DMY]
+//	_ = json.Unmarshal([]byte(`"04/07/2025"`), &due)
+//	// due.Time: 2025-07-04 00:00:00 +0000 UTC
+//	out, _ := json.Marshal(due)
+//	// out: "04/07/2025"
+type Formatted[L Layout] struct {
+	time.Time
+}
+
+// NewFormatted wraps t so that it is encoded with the layout of L.
+//
+// Example:
+//
+//	due := gotime.NewFormatted[gotime.ISODateLayout](time.Now())
+func NewFormatted[L Layout](t time.Time) Formatted[L] {
+	return Formatted[L]{Time: t}
+}
+
+// ParseFormatted parses value with the layout of L.
+//
+// Example:
+//
+//	due, err := gotime.ParseFormatted[gotime.ISODateLayout]("2025-07-04")
+func ParseFormatted[L Layout](value string) (Formatted[L], error) {
+	var f Formatted[L]
+	err := f.UnmarshalText([]byte(value))
+	return f, err
+}
+
+// layout returns the NITES layout and the options of L.
+func (Formatted[L]) layout() (string, FormattedOptions) {
+	var l L
+	var opts FormattedOptions
+	if o, ok := interface{}(l).(LayoutOptions); ok {
+		opts = o.Options()
+	}
+	if opts.Location == nil {
+		opts.Location = time.UTC
+	}
+	return l.Layout(), opts
+}
+
+// String returns the time formatted with the layout of L.
+func (f Formatted[L]) String() string {
+	layout, _ := f.layout()
+	return nites.Format(f.Time, layout)
+}
+
+// MarshalText implements encoding.TextMarshaler. The zero time is encoded as an
+// empty string unless the options use ZeroAsValue.
+func (f Formatted[L]) MarshalText() ([]byte, error) {
+	layout, opts := f.layout()
+	if f.IsZero() && opts.Zero != ZeroAsValue {
+		return []byte{}, nil
+	}
+	return []byte(nites.Format(f.Time, layout)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. An empty string decodes
+// to the zero time unless the options use RejectEmpty.
+func (f *Formatted[L]) UnmarshalText(data []byte) error {
+	layout, opts := f.layout()
+	if len(data) == 0 {
+		if opts.RejectEmpty {
+			return ErrEmptyValue
+		}
+		f.Time = time.Time{}
+		return nil
+	}
+	t, err := parseValue(layout, string(data), opts.Location)
+	if err != nil {
+		return err
+	}
+	f.Time = t
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f Formatted[L]) MarshalJSON() ([]byte, error) {
+	_, opts := f.layout()
+	if f.IsZero() && opts.Zero == ZeroAsNull {
+		return []byte("null"), nil
+	}
+	text, _ := f.MarshalText()
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. JSON null decodes to the zero time
+// unless the options use RejectNull.
+func (f *Formatted[L]) UnmarshalJSON(data []byte) error {
+	_, opts := f.layout()
+	s := string(data)
+	if s == "null" {
+		if opts.RejectNull {
+			return ErrNullValue
+		}
+		f.Time = time.Time{}
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("gotime: Formatted value must be a JSON string, got %s", s)
+	}
+	return f.UnmarshalText([]byte(text))
+}
+
+// Value implements driver.Valuer. The value is stored as formatted text, and the
+// zero time is stored as NULL or an empty string depending on the options.
+func (f Formatted[L]) Value() (driver.Value, error) {
+	_, opts := f.layout()
+	if f.IsZero() && opts.Zero == ZeroAsNull {
+		return nil, nil
+	}
+	text, _ := f.MarshalText()
+	return string(text), nil
+}
+
+// Scan implements sql.Scanner. It accepts NULL, a time.Time and text in the
+// layout of L.
+func (f *Formatted[L]) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		if _, opts := f.layout(); opts.RejectNull {
+			return ErrNullValue
+		}
+		f.Time = time.Time{}
+		return nil
+	case time.Time:
+		f.Time = v
+		return nil
+	case string:
+		return f.UnmarshalText([]byte(v))
+	case []byte:
+		return f.UnmarshalText(v)
+	}
+	return fmt.Errorf("%w: cannot scan %T into gotime.Formatted", ErrUnsupportedScanType, src)
+}
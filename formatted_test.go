@@ -0,0 +1,157 @@
+package gotime_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+type dmyLayout struct{}
+
+func (dmyLayout) Layout() string { return "dd/mm/yyyy" }
+
+type strictDMYLayout struct{}
+
+func (strictDMYLayout) Layout() string { return "dd/mm/yyyy" }
+
+func (strictDMYLayout) Options() gotime.FormattedOptions {
+	return gotime.FormattedOptions{Zero: gotime.ZeroAsEmpty, RejectNull: true, RejectEmpty: true}
+}
+
+type istDateTimeLayout struct{}
+
+func (istDateTimeLayout) Layout() string { return "dd/mm/yyyy hhhh:ii" }
+
+func (istDateTimeLayout) Options() gotime.FormattedOptions {
+	return gotime.FormattedOptions{Location: time.FixedZone("IST", 5*3600+1800), Zero: gotime.ZeroAsValue}
+}
+
+func TestFormattedJSON(t *testing.T) {
+	type invoice struct {
+		Due gotime.Formatted[dmyLayout] `json:"due"`
+	}
+
+	data, err := json.Marshal(invoice{gotime.NewFormatted[dmyLayout](time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC))})
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, `{"due":"04/07/2025"}`, string(data))
+
+	data, err = json.Marshal(invoice{})
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, `{"due":null}`, string(data))
+
+	var inv invoice
+	utils.AssertEqual(t, nil, json.Unmarshal([]byte(`{"due":"31/12/2025"}`), &inv))
+	utils.AssertEqual(t, time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), inv.Due.Time)
+	utils.AssertEqual(t, "31/12/2025", inv.Due.String())
+	utils.AssertEqual(t, time.Wednesday, inv.Due.Weekday())
+
+	utils.AssertEqual(t, nil, json.Unmarshal([]byte(`{"due":null}`), &inv))
+	utils.AssertEqual(t, true, inv.Due.IsZero())
+	utils.AssertEqual(t, nil, json.Unmarshal([]byte(`{"due":""}`), &inv))
+	utils.AssertEqual(t, true, inv.Due.IsZero())
+
+	utils.AssertEqual(t, true, json.Unmarshal([]byte(`{"due":"2025-12-31"}`), &inv) != nil)
+	utils.AssertEqual(t, true, json.Unmarshal([]byte(`{"due":20251231}`), &inv) != nil)
+}
+
+func TestFormattedOptions(t *testing.T) {
+	var strict gotime.Formatted[strictDMYLayout]
+
+	data, err := json.Marshal(strict)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, `""`, string(data))
+
+	err = json.Unmarshal([]byte(`null`), &strict)
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrNullValue))
+	err = json.Unmarshal([]byte(`""`), &strict)
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrEmptyValue))
+	utils.AssertEqual(t, true, errors.Is(strict.Scan(nil), gotime.ErrNullValue))
+
+	v, err := strict.Value()
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, "", v)
+
+	var ist gotime.Formatted[istDateTimeLayout]
+	utils.AssertEqual(t, nil, json.Unmarshal([]byte(`"04/07/2025 09:30"`), &ist))
+	utils.AssertEqual(t, true, ist.Equal(time.Date(2025, 7, 4, 4, 0, 0, 0, time.UTC)))
+
+	data, err = json.Marshal(gotime.Formatted[istDateTimeLayout]{})
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, `"01/01/0001 00:00"`, string(data))
+}
+
+func TestFormattedText(t *testing.T) {
+	f, err := gotime.ParseFormatted[gotime.ISODateLayout]("2025-07-04")
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC), f.Time)
+
+	text, err := f.MarshalText()
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, "2025-07-04", string(text))
+
+	text, err = gotime.Formatted[gotime.ISODateLayout]{}.MarshalText()
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, "", string(text))
+
+	rfc, err := gotime.ParseFormatted[gotime.RFC3339Layout]("2025-07-04T09:30:00+05:30")
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, true, rfc.Equal(time.Date(2025, 7, 4, 4, 0, 0, 0, time.UTC)))
+	utils.AssertEqual(t, "2025-07-04T09:30:00+05:30", rfc.String())
+
+	_, err = gotime.ParseFormatted[gotime.ISODateLayout]("04/07/2025")
+	utils.AssertEqual(t, true, err != nil)
+}
+
+func TestFormattedSQL(t *testing.T) {
+	f := gotime.NewFormatted[dmyLayout](time.Date(2025, 7, 4, 15, 0, 0, 0, time.UTC))
+	v, err := f.Value()
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, "04/07/2025", v)
+
+	v, err = gotime.Formatted[dmyLayout]{}.Value()
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, nil, v)
+
+	tests := []struct {
+		name string
+		src  interface{}
+		want time.Time
+	}{
+		{"nil", nil, time.Time{}},
+		{"string", "04/07/2025", time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC)},
+		{"bytes", []byte("04/07/2025"), time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC)},
+		{"time", time.Date(2025, 7, 4, 15, 0, 0, 0, time.UTC), time.Date(2025, 7, 4, 15, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got gotime.Formatted[dmyLayout]
+			utils.AssertEqual(t, nil, got.Scan(tc.src))
+			utils.AssertEqual(t, tc.want, got.Time)
+		})
+	}
+
+	var got gotime.Formatted[dmyLayout]
+	utils.AssertEqual(t, true, errors.Is(got.Scan(42), gotime.ErrUnsupportedScanType))
+}
+
+func ExampleFormatted() {
+	type event struct {
+		Name string                      `json:"name"`
+		On   gotime.Formatted[dmyLayout] `json:"on"`
+	}
+
+	var e event
+	_ = json.Unmarshal([]byte(`{"name":"Launch","on":"15/08/2025"}`), &e)
+	fmt.Println(e.On.Format(time.RFC1123))
+
+	out, _ := json.Marshal(e)
+	fmt.Println(string(out))
+	// Output:
+	// Fri, 15 Aug 2025 00:00:00 UTC
+	// {"name":"Launch","on":"15/08/2025"}
+}
@@ -0,0 +1,278 @@
+package gotime
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ErrInvalidDuration is returned when a human readable duration cannot be parsed.
+var ErrInvalidDuration = errors.New("invalid duration")
+
+// humanUnit is a unit accepted by ParseHumanDuration and ParseHumanPeriod.
+type humanUnit struct {
+	// fixed is the exact length of the unit, or 0 for calendar units.
+	fixed time.Duration
+
+	// period is the calendar unit for months, years and business days.
+	period string
+}
+
+// humanUnits maps the long and short unit names to their units.
+var humanUnits = map[string]humanUnit{}
+
+func init() {
+	for _, u := range []struct {
+		unit  humanUnit
+		names []string
+	}{
+		{humanUnit{fixed: time.Nanosecond}, []string{"ns", "nsec", "nanosecond", "nanoseconds"}},
+		{humanUnit{fixed: time.Microsecond}, []string{"us", "µs", "usec", "microsecond", "microseconds"}},
+		{humanUnit{fixed: time.Millisecond}, []string{"ms", "msec", "millisecond", "milliseconds"}},
+		{humanUnit{fixed: time.Second}, []string{"s", "sec", "secs", "second", "seconds"}},
+		{humanUnit{fixed: time.Minute}, []string{"m", "min", "mins", "minute", "minutes"}},
+		{humanUnit{fixed: time.Hour}, []string{"h", "hr", "hrs", "hour", "hours"}},
+		{humanUnit{fixed: 24 * time.Hour}, []string{"d", "day", "days"}},
+		{humanUnit{fixed: 7 * 24 * time.Hour}, []string{"w", "wk", "wks", "week", "weeks"}},
+		{humanUnit{period: "month"}, []string{"mo", "mos", "month", "months"}},
+		{humanUnit{period: "year"}, []string{"y", "yr", "yrs", "year", "years"}},
+		{humanUnit{period: "business day"}, []string{"bd", "business day", "business days", "working day", "working days", "workday", "workdays"}},
+	} {
+		for _, name := range u.names {
+			humanUnits[name] = u.unit
+		}
+	}
+}
+
+// humanComponent is a single "<number> <unit>" part of a human readable duration.
+type humanComponent struct {
+	number string
+	name   string
+	unit   humanUnit
+}
+
+// parseHumanComponents splits a human readable duration into its components.
+// A leading minus sign negates the whole duration.
+func parseHumanComponents(s string) (negative bool, comps []humanComponent, err error) {
+	invalid := func(reason string) (bool, []humanComponent, error) {
+		return false, nil, fmt.Errorf("%w: %q: %s", ErrInvalidDuration, s, reason)
+	}
+
+	rest := strings.ToLower(strings.TrimSpace(s))
+	if rest == "less than 1 second" {
+		// The output of DurationInWords for sub-second durations.
+		return false, nil, nil
+	}
+	if strings.HasPrefix(rest, "-") || strings.HasPrefix(rest, "+") {
+		negative = rest[0] == '-'
+		rest = strings.TrimSpace(rest[1:])
+	}
+
+	for {
+		rest = strings.TrimLeftFunc(rest, func(r rune) bool { return unicode.IsSpace(r) || r == ',' })
+		if strings.HasPrefix(rest, "and ") {
+			rest = rest[len("and "):]
+			continue
+		}
+		if rest == "" {
+			break
+		}
+
+		// Read the number. "a" and "an" stand for one, as in "an hour".
+		var number string
+		switch {
+		case strings.HasPrefix(rest, "an "):
+			number, rest = "1", rest[len("an "):]
+		case strings.HasPrefix(rest, "a "):
+			number, rest = "1", rest[len("a "):]
+		default:
+			i := strings.IndexFunc(rest, func(r rune) bool { return !(r >= '0' && r <= '9' || r == '.') })
+			if i < 0 {
+				return invalid("missing unit")
+			}
+			number, rest = rest[:i], rest[i:]
+		}
+		if number == "" {
+			return invalid("expected a number")
+		}
+
+		// Read the unit name, which may be two words ("business days").
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+		i := strings.IndexFunc(rest, func(r rune) bool { return !unicode.IsLetter(r) })
+		if i < 0 {
+			i = len(rest)
+		}
+		name := rest[:i]
+		rest = rest[i:]
+		if name == "business" || name == "working" {
+			second := strings.TrimLeftFunc(rest, unicode.IsSpace)
+			j := strings.IndexFunc(second, func(r rune) bool { return !unicode.IsLetter(r) })
+			if j < 0 {
+				j = len(second)
+			}
+			name += " " + second[:j]
+			rest = second[j:]
+		}
+		unit, ok := humanUnits[name]
+		if !ok {
+			if name == "" {
+				return invalid("missing unit")
+			}
+			return invalid(fmt.Sprintf("unknown unit %q", name))
+		}
+		comps = append(comps, humanComponent{number: number, name: name, unit: unit})
+	}
+
+	if len(comps) == 0 {
+		return invalid("empty duration")
+	}
+	return negative, comps, nil
+}
+
+// ParseHumanDuration parses a human readable duration such as "2d 4h",
+// "90 min", "1.5 weeks" or "1 week and 2 days". It is the inverse of
+// DurationInWords.
+//
+// Units may be written in long or short form (ns, us, ms, s/sec/second,
+// m/min/minute, h/hr/hour, d/day, w/wk/week) and may have a decimal fraction.
+// Components may be separated by spaces, commas or "and", and a leading minus
+// sign negates the whole duration. A day is 24 hours. Months, years and
+// business days have no fixed length and are rejected; use ParseHumanPeriod
+// for them.
+//
+// Example:
+//
+//	d, err := gotime.ParseHumanDuration("1 day 2 hours")
+//	// d: 26h0m0s
+//	d, err = gotime.ParseHumanDuration("-1.5h")
+//	// d: -1h30m0s
+func ParseHumanDuration(s string) (time.Duration, error) {
+	negative, comps, err := parseHumanComponents(s)
+	if err != nil {
+		return 0, err
+	}
+
+	var total time.Duration
+	for _, c := range comps {
+		if c.unit.fixed == 0 {
+			return 0, fmt.Errorf("%w: %q: %q has no fixed length, use ParseHumanPeriod", ErrInvalidDuration, s, c.name)
+		}
+		d, ok := parseDecimalDuration(c.number, c.unit.fixed)
+		if !ok {
+			return 0, fmt.Errorf("%w: %q: bad number %q", ErrInvalidDuration, s, c.number)
+		}
+		total += d
+	}
+	if negative {
+		total = -total
+	}
+	return total, nil
+}
+
+// HumanPeriod is the result of ParseHumanPeriod: a calendar period plus a
+// number of business days.
+type HumanPeriod struct {
+	Period       Period
+	BusinessDays int
+}
+
+// ParseHumanPeriod parses a human readable duration like ParseHumanDuration but
+// keeps calendar units: days, weeks, months and years go into the date part of
+// the period and business days are counted separately, so "1 month 3 business
+// days" is not turned into a fixed number of hours.
+//
+// Fractions are allowed on weeks, days and clock units; the part of a day left
+// over from them is added to the time part. Months, years and business days
+// must be whole numbers.
+//
+// Example:
+//
+//	p, err := gotime.ParseHumanPeriod("1 month and 3 business days")
+//	// p: HumanPeriod{Period: Period{Months: 1}, BusinessDays: 3}
+func ParseHumanPeriod(s string) (HumanPeriod, error) {
+	negative, comps, err := parseHumanComponents(s)
+	if err != nil {
+		return HumanPeriod{}, err
+	}
+
+	var hp HumanPeriod
+	for _, c := range comps {
+		bad := fmt.Errorf("%w: %q: bad number %q", ErrInvalidDuration, s, c.number)
+
+		if c.unit.fixed == 0 {
+			n, err := strconv.Atoi(c.number)
+			if err != nil {
+				return HumanPeriod{}, bad
+			}
+			switch c.unit.period {
+			case "month":
+				hp.Period.Months += n
+			case "year":
+				hp.Period.Years += n
+			default:
+				hp.BusinessDays += n
+			}
+			continue
+		}
+
+		d, ok := parseDecimalDuration(c.number, c.unit.fixed)
+		if !ok {
+			return HumanPeriod{}, bad
+		}
+		if c.unit.fixed < 24*time.Hour {
+			hp.Period.Time += d
+			continue
+		}
+		// Whole days of days and weeks are calendar days; the rest is time.
+		days := int(d / (24 * time.Hour))
+		if c.unit.fixed > 24*time.Hour && d%c.unit.fixed == 0 {
+			hp.Period.Weeks += days / 7
+		} else {
+			hp.Period.Days += days
+		}
+		hp.Period.Time += d % (24 * time.Hour)
+	}
+	if negative {
+		hp.Period = hp.Period.Negate()
+		hp.BusinessDays = -hp.BusinessDays
+	}
+	return hp, nil
+}
+
+// AddTo returns t moved by the period and then by the business days, using the
+// same working days and holidays as WorkDay. Negative business days move
+// backwards. It returns ErrNoWorkingDays when business days are requested but
+// no weekday is a working day.
+//
+// Example:
+//
+//	p, _ := gotime.ParseHumanPeriod("3 business days")
+//	workdays := [7]bool{false, true, true, true, true, true, false} // Mon-Fri
+//	friday := time.Date(2025, 7, 4, 9, 0, 0, 0, time.UTC)
+//	due, _ := p.AddTo(friday, workdays)
+//	// due: 2025-07-09 09:00:00 (Wednesday)
+func (hp HumanPeriod) AddTo(t time.Time, workingDays [7]bool, holidays ...time.Time) (time.Time, error) {
+	t = hp.Period.AddTo(t)
+	if hp.BusinessDays == 0 {
+		return t, nil
+	}
+	if workingDays == [7]bool{} {
+		return time.Time{}, ErrNoWorkingDays
+	}
+
+	holidayLookup := newHolidaySet(holidays)
+	step, n := 1, hp.BusinessDays
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		t = t.AddDate(0, 0, step)
+		if workingDays[t.Weekday()] && !holidayLookup.contains(t) {
+			n--
+		}
+	}
+	return t, nil
+}
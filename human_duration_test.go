@@ -0,0 +1,139 @@
+package gotime_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestParseHumanDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"2d 4h", 52 * time.Hour},
+		{"2d4h", 52 * time.Hour},
+		{"90 min", 90 * time.Minute},
+		{"90min", 90 * time.Minute},
+		{"1 week and 2 days", 9 * 24 * time.Hour},
+		{"1.5 weeks", 252 * time.Hour},
+		{"1.5h", 90 * time.Minute},
+		{"2 hours, 30 minutes", 150 * time.Minute},
+		{"an hour and 30 minutes", 90 * time.Minute},
+		{"a day", 24 * time.Hour},
+		{"-1h 30m", -90 * time.Minute},
+		{"+45s", 45 * time.Second},
+		{"1 Day 2 Hours", 26 * time.Hour},
+		{"250ms", 250 * time.Millisecond},
+		{"3 µs 5 ns", 3*time.Microsecond + 5},
+		{"0 seconds", 0},
+		{"less than 1 second", 0},
+		{"0.000000001s", 1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := gotime.ParseHumanDuration(tc.in)
+			utils.AssertEqual(t, nil, err)
+			utils.AssertEqual(t, tc.want, got)
+		})
+	}
+}
+
+func TestParseHumanDurationInvalid(t *testing.T) {
+	for _, in := range []string{"", "5", "hours", "5 fortnights", "1.2.3 h", "2 months", "3 business days", "1 year", "5h and", "-", "1. h"} {
+		t.Run(in, func(t *testing.T) {
+			_, err := gotime.ParseHumanDuration(in)
+			utils.AssertEqual(t, true, errors.Is(err, gotime.ErrInvalidDuration))
+		})
+	}
+}
+
+func TestParseHumanDurationRoundTrip(t *testing.T) {
+	durations := []time.Duration{
+		0,
+		time.Second,
+		45 * time.Second,
+		time.Minute,
+		5*time.Minute + 30*time.Second,
+		time.Hour,
+		2*time.Hour + 15*time.Minute,
+		24 * time.Hour,
+		26 * time.Hour,
+		3*24*time.Hour + 4*time.Hour,
+		-(2*time.Hour + 30*time.Minute),
+	}
+	for _, d := range durations {
+		words := gotime.DurationInWords(d)
+		t.Run(words, func(t *testing.T) {
+			got, err := gotime.ParseHumanDuration(words)
+			utils.AssertEqual(t, nil, err)
+			utils.AssertEqual(t, d, got)
+		})
+	}
+}
+
+func TestParseHumanPeriod(t *testing.T) {
+	tests := []struct {
+		in   string
+		want gotime.HumanPeriod
+	}{
+		{"3 business days", gotime.HumanPeriod{BusinessDays: 3}},
+		{"1 month and 3 working days", gotime.HumanPeriod{Period: gotime.Period{Months: 1}, BusinessDays: 3}},
+		{"1y 2mo 3d 4h", gotime.HumanPeriod{Period: gotime.Period{Years: 1, Months: 2, Days: 3, Time: 4 * time.Hour}}},
+		{"2 weeks", gotime.HumanPeriod{Period: gotime.Period{Weeks: 2}}},
+		{"1.5 weeks", gotime.HumanPeriod{Period: gotime.Period{Days: 10, Time: 12 * time.Hour}}},
+		{"1.5 days", gotime.HumanPeriod{Period: gotime.Period{Days: 1, Time: 12 * time.Hour}}},
+		{"-1 month 2 bd", gotime.HumanPeriod{Period: gotime.Period{Months: -1}, BusinessDays: -2}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := gotime.ParseHumanPeriod(tc.in)
+			utils.AssertEqual(t, nil, err)
+			utils.AssertEqual(t, tc.want, got)
+		})
+	}
+
+	for _, in := range []string{"1.5 months", "0.5 business days", "2 lightyears"} {
+		_, err := gotime.ParseHumanPeriod(in)
+		utils.AssertEqual(t, true, errors.Is(err, gotime.ErrInvalidDuration))
+	}
+}
+
+func TestHumanPeriodAddTo(t *testing.T) {
+	workdays := [7]bool{false, true, true, true, true, true, false}
+	holidays := []time.Time{time.Date(2025, 7, 7, 0, 0, 0, 0, time.UTC)}
+	friday := time.Date(2025, 7, 4, 9, 0, 0, 0, time.UTC)
+
+	p, err := gotime.ParseHumanPeriod("3 business days")
+	utils.AssertEqual(t, nil, err)
+	got, err := p.AddTo(friday, workdays)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, time.Date(2025, 7, 9, 9, 0, 0, 0, time.UTC), got)
+
+	got, err = p.AddTo(friday, workdays, holidays...)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, time.Date(2025, 7, 10, 9, 0, 0, 0, time.UTC), got)
+
+	back := gotime.HumanPeriod{BusinessDays: -1}
+	got, err = back.AddTo(time.Date(2025, 7, 8, 9, 0, 0, 0, time.UTC), workdays, holidays...)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, friday, got)
+
+	mixed := gotime.HumanPeriod{Period: gotime.Period{Months: 1}, BusinessDays: 1}
+	got, err = mixed.AddTo(friday, workdays)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, time.Date(2025, 8, 5, 9, 0, 0, 0, time.UTC), got)
+
+	_, err = p.AddTo(friday, [7]bool{})
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrNoWorkingDays))
+}
+
+func ExampleParseHumanDuration() {
+	d, _ := gotime.ParseHumanDuration("1 week and 2.5 days")
+	fmt.Println(d, gotime.DurationInWords(d))
+	// Output: 228h0m0s 9 days 12 hours
+}
@@ -1,7 +1,6 @@
 package nites
 
 import (
-	"errors"
 	"strings"
 	"time"
 
@@ -11,7 +10,8 @@ import (
 
 // Convert function converts a datetime from one string format to another.
 // It takes the datetime string in the single format and converts it to the expected output.
-// It returns an error when the format is not supported.
+// It returns a *ParseError when dt does not match from or the format is not
+// supported.
 func Convert(dt string, from string, to string) (string, error) {
 	if from == to {
 		return dt, nil
@@ -22,27 +22,30 @@ func Convert(dt string, from string, to string) (string, error) {
 	// contains ordinals (mt, dt).
 	fromConverted, err := convertLayout(from, true)
 	if err != nil {
-		return "", err
+		return "", newParseError(from, dt, err)
 	}
 
-	var fromLayout string
+	var t time.Time
 	switch v := fromConverted.(type) {
 	case []string:
-		fromLayout = v[0]
+		if len(v) == 1 {
+			t, err = time.Parse(v[0], dt)
+		} else {
+			t, err = parseSegments(v, dt, time.UTC, time.Parse)
+		}
 	default:
-		fromLayout, _ = v.(string)
+		fromLayout, _ := v.(string)
+		t, err = time.Parse(fromLayout, dt)
 	}
-
-	toLayout, _ := convertLayout(to, false) // ConvertLayout never returns an error when forParsing is false
-
-	t, err := time.Parse(fromLayout, dt)
 	if err != nil {
-		return "", err
+		return "", newParseError(from, dt, err)
 	}
 
+	toLayout, _ := convertLayout(to, false) // ConvertLayout never returns an error when forParsing is false
+
 	switch v := toLayout.(type) {
 	case []string:
-		return formatStrs(t, v), nil
+		return formatStrs(t, v, t.Year(), quarterOf(t.Month())), nil
 	default:
 		vstr, _ := v.(string)
 		return t.Format(vstr), nil
@@ -67,8 +70,18 @@ func Convert(dt string, from string, to string) (string, error) {
 // dt     -> 2nd        Day in ordinal format with leading zero (not supported during parsing)
 
 // ddd    -> 002        Zero padded day of year
+// dn     -> 2          Day of year without leading zeros
 // www    -> Mon        Three letter weekday name
 // wwww   -> Monday     Full weekday name
+// ww     -> 01         Week of year, the week of January 1st being week 1 and
+//                      weeks starting on Sunday
+// wi     -> 01         ISO 8601 week of the ISO week-numbering year
+// q      -> 1          Quarter
+// qq     -> Q1         Quarter with a Q
+// fyyyy  -> 2006       Four digit fiscal year (not supported during parsing)
+// fyy    -> 06         Two digit fiscal year (not supported during parsing)
+// era    -> AD         Era, AD or BC; yyyy and yy then write the year of the
+//                      era, so year 0 is 0001 BC
 
 // h      -> 3          Hour in 12 hour format without leading zero
 // hh     -> 03         Hour in 12 hour format with leading zero
@@ -81,12 +94,25 @@ func Convert(dt string, from string, to string) (string, error) {
 // s      -> 5          Second without leading zero
 // 0      -> 0          Microsecond with leading zero
 // 9      -> 9          Microsecond without leading zero
+// ms     -> 000        Milliseconds, always three digits
+// us     -> 000000     Microseconds, always six digits
+// ns     -> 000000000  Nanoseconds, always nine digits
 
 // z      -> Z      		The Z literal represents UTC
 // zz     -> MST        Timezone abbreviation
+// zzzz   -> America/Denver  Timezone name
 // o     -> ±07     		Timezone offset with leading zero (only hours)
 // oo    -> ±0700       Timezone offset with leading zero without colon
 // ooo   -> ±07:00      Timezone offset with leading zero with colon
+
+// unix   -> 1136214245     Unix time in seconds
+// unixms -> 1136214245000  Unix time in milliseconds
+
+// \x, 'text', [text]  -> Literal text, see scan
+//
+// The result is a Go layout string, or a list of segments alternating between
+// Go layouts and the tokens or literal text that Go can't handle, such as
+// ["Jan ", "dt", ""] for "mmm dt".
 func convertLayout(f string, forParsing bool) (interface{}, error) {
 	// Built-in format, return as is
 	if version, ok := utils.BuiltInLayouts[f]; ok {
@@ -100,97 +126,51 @@ func convertLayout(f string, forParsing bool) (interface{}, error) {
 		return v, nil
 	}
 
-	// Convert format to lower case for case insensitive matching
-	var converted interface{}
-
-	// Initialize a map of format conversions
-	conversions := map[string][][]string{
-		"y": {{"yyyy", "2006"}, {"yy", "06"}},
-		"m": {{"mmmm", "January"}, {"mmm", "Jan"}, {"mm", "01"}, {"mt", ""}, {"m", "1"}},
-		"d": {{"ddd", "002"}, {"dd", "02"}, {"db", "_2"}, {"dt", ""}, {"d", "2"}}, // dt for ordinals
-		"w": {{"wwww", "Monday"}, {"www", "Mon"}},
-		"h": {{"hhhh", "15"}, {"hh", "03"}, {"h", "3"}},
-		"a": {{"aa", "PM"}, {"a", "pm"}},
-		"i": {{"ii", "04"}, {"i", "4"}},
-		"s": {{"ss", "05"}, {"s", "5"}},
-
-		// Timezone
-		"z": {
-			{"zz", "MST"},
-			{"z", "Z"},
-		},
-		"o": {{"ooo", "-07:00"}, {"oo", "-0700"}, {"o", "-07"}},
-	}
+	var converted []string
 
 	// Initialize a new string builder
 	to := strings.Builder{}
 
-	// Loop through the input format
-	i := 0
-	for i < len(f) {
-		c := f[i]
-
-		// If c is uppercase, convert it to lowercase
-		if c >= 'A' && c <= 'Z' {
-			c += 32
-		}
-
-		// Check if the current character is an escape character
-		if f[i] == '\\' {
-			// Check if we're not at the end of the format string
-			if i+1 < len(f) {
-				// Append the next character as a literal, ignoring its format meaning
-				to.WriteString(string(f[i+1]))
-				i += 2
+	items := scan(f)
+	for i := 0; i < len(items); {
+		it := items[i]
+
+		// Escaped and quoted text is kept apart from the Go layout when Go
+		// would read it as a layout element, or join it with one.
+		if it.escaped {
+			j := i
+			var lit strings.Builder
+			for j < len(items) && items[j].escaped {
+				lit.WriteString(items[j].text)
+				j++
+			}
+			if misread(to.String(), lit.String(), goText(items[j:])) {
+				converted = append(converted, to.String(), literalSegment+lit.String())
+				to.Reset()
 			} else {
-				// We're at the end of the format string; append the escape character
-				to.WriteString(string(f[i]))
-				i++
+				to.WriteString(lit.String())
 			}
+			i = j
 			continue
 		}
+		i++
 
-		// Check if the current character is a valid format character
-		conv, ok := conversions[string(c)]
-		if !ok {
-			// Not a valid format character, add as is
-			to.WriteString(string(f[i]))
-			i++
+		if it.token == "" {
+			to.WriteString(it.text)
 			continue
 		}
 
-		// Valid format character, check for the longest possible match
-		for _, keyVal := range conv {
-			key, val := keyVal[0], keyVal[1]
-			iEnd := i + len(key)
-
-			// Check if the len of key + i is less than the len of the format.
-			if iEnd <= len(f) {
-				if f[i:iEnd] == key {
-					if val == "" {
-						if forParsing {
-							return nil, errors.New(errOrdinalsNotSupported)
-						}
-						if converted == nil {
-							converted = []string{}
-						}
-						converted = append(converted.([]string), to.String()) // Append the converted format
-						converted = append(converted.([]string), key)         // Append the value to the converted format
-						to.Reset()
-					}
-					to.WriteString(val)
-					i += len(key)
-					goto MatchFound
-				}
+		val := tokenValues[it.token]
+		if val == "" {
+			// Tokens without a Go equivalent split the layout into segments.
+			if forParsing && isOrdinal(it.token) {
+				return nil, fieldError(it.token, ErrOrdinalsNotSupported)
 			}
+			converted = append(converted, to.String(), it.token)
+			to.Reset()
+			continue
 		}
-
-		// If we get here, we didn't find a match, add the character as is
-		if i < len(f) {
-			to.WriteString(string(f[i]))
-			i++
-		}
-	MatchFound:
+		to.WriteString(val)
 	}
 
 	// Cache the converted format
@@ -200,8 +180,70 @@ func convertLayout(f string, forParsing bool) (interface{}, error) {
 		return finalConvert, nil
 	}
 
-	converted = append(converted.([]string), finalConvert)
+	converted = append(converted, finalConvert)
 
 	cache.Set(f, converted)
 	return converted, nil
 }
+
+// literalSegment marks a segment of a converted layout that is literal text,
+// such as "'at" for the text "at".
+const literalSegment = "'"
+
+// goText returns the Go layout text of items, up to the first token without a
+// Go equivalent.
+func goText(items []item) string {
+	var b strings.Builder
+	for _, it := range items {
+		if it.token == "" {
+			b.WriteString(it.text)
+			continue
+		}
+		val := tokenValues[it.token]
+		if val == "" {
+			break
+		}
+		b.WriteString(val)
+	}
+	return b.String()
+}
+
+// misread reports whether Go would read literal text placed between before and
+// after differently from plain text: as a layout element, such as "1" or "pm",
+// or as part of one with its neighbours, such as "_" before "2".
+func misread(before, lit, after string) bool {
+	joined := goLayoutElements(before + lit + after)
+	apart := append(goLayoutElements(before), goLayoutElements(after)...)
+	if len(joined) != len(apart) {
+		return true
+	}
+	for i := range joined {
+		if joined[i] != apart[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// goLayoutElements returns the layout elements of a Go layout.
+func goLayoutElements(layout string) []string {
+	var elems []string
+	for _, t := range tokenizeGo(layout) {
+		if !t.IsLiteral() {
+			elems = append(elems, t.Text)
+		}
+	}
+	return elems
+}
+
+// isOrdinal reports whether key is an ordinal token, which can only be
+// formatted.
+func isOrdinal(key string) bool {
+	return key == "dt" || key == "mt"
+}
+
+// isFiscal reports whether key is a fiscal year token, which can only be
+// formatted since the fiscal calendar isn't known.
+func isFiscal(key string) bool {
+	return key == "fyyyy" || key == "fyy"
+}
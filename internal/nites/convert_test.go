@@ -380,3 +380,45 @@ func TestConvertFromFormatWithMultipleFormats(t *testing.T) {
 		t.Errorf("Expected 'ordinals not supported' error, got: %v", err)
 	}
 }
+
+func TestConvertUnix(t *testing.T) {
+	date, err := nites.Convert("1720512000", "unix", "yyyy-mm-dd hhhh:ii:ss")
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, "2024-07-09 08:00:00", date)
+
+	date, err = nites.Convert("2024-07-09 08:00:00.25", "yyyy-mm-dd hhhh:ii:ss.999", "unixms")
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, "1720512000250", date)
+
+	date, err = nites.Convert("1720512000250", "unixms", "unix")
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, "1720512000", date)
+}
+
+func TestConvertQuotedLiterals(t *testing.T) {
+	layouts := []struct {
+		quoted, plain string
+		value         string
+	}{
+		{"yyyy-mm-dd'T'hhhh:ii", "yyyy-mm-ddThhhh:ii", "2025-07-04T15:05"},
+		{"wwww dd mmm yyyy 'at' hh:ii aa", "yyyy-mm-dd hhhh:ii", "Friday 04 Jul 2025 at 03:05 PM"},
+		{"[Week of] mmm d, yyyy", "yyyy-mm-dd", "Week of Jul 4, 2025"},
+		{"hhhh 'o''clock' 'on the' dd", "dd hhhh", "15 o'clock on the 04"},
+		{"'at 3pm' yyyy/mm/dd", "yyyy-mm-dd", "at 3pm 2025/07/04"},
+	}
+	for _, tc := range layouts {
+		t.Run(tc.quoted, func(t *testing.T) {
+			// Converting away from the quoted layout and back gives the value.
+			plain, err := nites.Convert(tc.value, tc.quoted, tc.plain)
+			utils.AssertNoError(t, err)
+			back, err := nites.Convert(plain, tc.plain, tc.quoted)
+			utils.AssertNoError(t, err)
+			utils.AssertEqual(t, tc.value, back)
+
+			// The quoted layout formats what it parses.
+			parsed, err := nites.Parse(tc.quoted, tc.value)
+			utils.AssertNoError(t, err)
+			utils.AssertEqual(t, tc.value, nites.Format(parsed, tc.quoted))
+		})
+	}
+}
@@ -1,4 +1,150 @@
 package nites
 
-const errOrdinalsNotSupported = "ordinals not supported during parsing"
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
 const errInvalidFormat = "invalid format"
+
+var (
+	// ErrOrdinalsNotSupported is returned when parsing with an ordinal token,
+	// "dt" or "mt", which can only be formatted.
+	ErrOrdinalsNotSupported = errors.New("ordinals not supported during parsing")
+
+	// ErrFiscalNotSupported is returned when parsing with a fiscal year token,
+	// "fyyyy" or "fyy", which can only be formatted.
+	ErrFiscalNotSupported = errors.New("fiscal years not supported during parsing")
+
+	// ErrEpochWithFields is returned when parsing with a unix time token and
+	// another field, since the unix time alone gives the instant.
+	ErrEpochWithFields = errors.New("unix time can only be combined with literal text during parsing")
+)
+
+// ParseError describes a value that doesn't match a NITES layout. Err is the
+// underlying error: a *time.ParseError when Go's time package rejected the
+// value, one of the sentinel errors such as ErrOrdinalsNotSupported, or an
+// error describing the mismatch.
+type ParseError struct {
+	Layout string // the NITES layout
+	Value  string // the value being parsed
+	Token  string // the token or literal text of the layout that failed, if known
+	Offset int    // the byte offset in Value where parsing failed, or -1
+	Hint   string // a suggestion for fixing the layout or the value, if any
+	Err    error
+}
+
+// Error returns the error in the form used by the time package, with the
+// NITES layout and token, followed by the hint.
+//
+// Example:
+//
+//	parsing time "5/7/2024" as "dd/mm/yyyy": cannot parse "5/7/2024" as "dd"; "dd" needs two digits; use "d" to accept one
+func (e *ParseError) Error() string {
+	msg := fmt.Sprintf("parsing time %q as %q", e.Value, e.Layout)
+	var pe *time.ParseError
+	switch {
+	case errors.As(e.Err, &pe) && pe.Message == "":
+		msg += fmt.Sprintf(": cannot parse %q as %q", pe.ValueElem, e.Token)
+	case errors.As(e.Err, &pe):
+		msg += pe.Message
+	default:
+		msg += ": " + e.Err.Error()
+	}
+	if e.Hint != "" {
+		msg += "; " + e.Hint
+	}
+	return msg
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// fieldError returns a ParseError for a token at an unknown offset.
+func fieldError(token string, err error) *ParseError {
+	return &ParseError{Token: token, Offset: -1, Err: err}
+}
+
+// newParseError describes err, returned when parsing value with layout, as a
+// ParseError. Errors from Go's time package are described in terms of the
+// NITES tokens.
+func newParseError(layout, value string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	e, ok := err.(*ParseError)
+	var pe *time.ParseError
+	if !ok {
+		e = &ParseError{Offset: -1, Err: err}
+	}
+	if errors.As(err, &pe) {
+		e.Token = tokenOf(pe.LayoutElem)
+		if (pe.Message == "" || strings.HasPrefix(pe.Message, ": extra text")) && strings.HasSuffix(value, pe.ValueElem) {
+			e.Offset = len(value) - len(pe.ValueElem)
+		}
+	}
+	e.Layout, e.Value = layout, value
+	if e.Hint == "" {
+		e.Hint = hint(e)
+	}
+	return e
+}
+
+// tokenOf returns the NITES token for an element of a Go layout, or the
+// element itself if it has none. Go reports literal text together with the
+// spaces around it, which are dropped, so "at " is reported as "at".
+func tokenOf(elem string) string {
+	if tok, ok := nitesTokens[goElements[elem]]; ok {
+		return tok
+	}
+	if text := strings.TrimSpace(elem); text != "" {
+		return text
+	}
+	return elem
+}
+
+// unpadded maps the padded tokens to the tokens that also accept one digit.
+var unpadded = map[string]string{"mm": "m", "dd": "d", "hh": "h", "ii": "i", "ss": "s"}
+
+// hint suggests a fix for some common mistakes.
+func hint(e *ParseError) string {
+	var pe *time.ParseError
+	switch {
+	case errors.Is(e.Err, ErrOrdinalsNotSupported) && e.Token != "":
+		return fmt.Sprintf(`use %q and remove the suffix, such as the "th" in "4th"`, e.Token[:1])
+	case errors.Is(e.Err, ErrEpochWithFields):
+		return "parse the unix time on its own"
+	case !errors.As(e.Err, &pe):
+		return ""
+	case strings.HasPrefix(pe.Message, ": extra text"):
+		return "the value goes on after the end of the layout"
+	case pe.Message != "":
+		return ""
+	case pe.ValueElem == "":
+		return "the value ends before the layout does"
+	}
+
+	rest := e.Value[e.Offset:]
+	if e.Offset < 0 {
+		rest = ""
+	}
+	oneDigit := len(rest) > 0 && isDigit(rest[0]) && (len(rest) == 1 || !isDigit(rest[1]))
+	switch tok := e.Token; {
+	case unpadded[tok] != "" && oneDigit:
+		return fmt.Sprintf("%q needs two digits; use %q to accept one", tok, unpadded[tok])
+	case tok == "aa":
+		return `"aa" expects "AM" or "PM"`
+	case tok == "a":
+		return `"a" expects "am" or "pm"`
+	}
+	return ""
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
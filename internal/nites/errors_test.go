@@ -0,0 +1,104 @@
+package nites_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2/internal/nites"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		layout, value string
+		token         string
+		offset        int
+		hint          string
+	}{
+		{"dd/mm/yyyy", "5/7/2024", "dd", 0, `"dd" needs two digits; use "d" to accept one`},
+		{"dd/mm/yyyy", "05/7/2024", "mm", 3, `"mm" needs two digits; use "m" to accept one`},
+		{"dd/mm/yyyy", "05-07-2024", "/", 2, ""},
+		{"dd/mm/yyyy", "05/07", "/", 5, "the value ends before the layout does"},
+		{"dd/mm/yyyy", "05/07/2024 10:30", "", 10, "the value goes on after the end of the layout"},
+		{"dd/mm/yyyy", "05/13/2024", "mm", -1, ""},
+		{"hh:ii aa", "03:05 pm", "aa", 6, `"aa" expects "AM" or "PM"`},
+		{"yyyy-mm-dd ooo", "2024-07-05 IST", "ooo", 11, ""},
+		{"'at' hhhh", "by 15", "at", 0, ""},
+		{"[yyyy] dd", "2024 05", "yyyy", 0, ""},
+		{"q yyyy", "5 2024", "q", 0, ""},
+		{"qq yyyy", "Q5 2024", "qq", -1, ""},
+		{"dn yyyy", "x 2024", "dn", 0, ""},
+		{"yyyy dn", "2025 366", "dn", -1, ""},
+		{"yyyy-mm-dd qq", "2025-07-04 Q1", "qq", -1, ""},
+		{"@unix", "@17205x", "", 6, ""},
+		{"dt mmmm", "4th July", "dt", -1, `use "d" and remove the suffix, such as the "th" in "4th"`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.layout+" "+tc.value, func(t *testing.T) {
+			_, err := nites.Parse(tc.layout, tc.value)
+			var pe *nites.ParseError
+			utils.AssertEqual(t, true, errors.As(err, &pe))
+			utils.AssertEqual(t, tc.layout, pe.Layout)
+			utils.AssertEqual(t, tc.value, pe.Value)
+			utils.AssertEqual(t, tc.token, pe.Token)
+			utils.AssertEqual(t, tc.offset, pe.Offset)
+			utils.AssertEqual(t, tc.hint, pe.Hint)
+		})
+	}
+}
+
+func TestParseErrorWrapsGoError(t *testing.T) {
+	_, err := nites.Parse("dd/mm/yyyy", "5/7/2024")
+	utils.AssertEqual(t, `parsing time "5/7/2024" as "dd/mm/yyyy": cannot parse "5/7/2024" as "dd"; "dd" needs two digits; use "d" to accept one`, err.Error())
+
+	var goErr *time.ParseError
+	utils.AssertEqual(t, true, errors.As(err, &goErr))
+	utils.AssertEqual(t, "02/01/2006", goErr.Layout)
+	utils.AssertEqual(t, "02", goErr.LayoutElem)
+
+	_, err = nites.ParseInLocation("yyyy-mm-dd", "2024-02-30", time.UTC)
+	utils.AssertEqual(t, `parsing time "2024-02-30" as "yyyy-mm-dd": day out of range`, err.Error())
+	utils.AssertEqual(t, true, errors.As(err, &goErr))
+
+	// A failing segment token is reported on its own.
+	_, err = nites.Parse("q yyyy", "5 2024")
+	utils.AssertEqual(t, `parsing time "5 2024" as "q yyyy": cannot parse "5 2024" as "q"`, err.Error())
+	utils.AssertEqual(t, true, errors.As(err, &goErr))
+	utils.AssertEqual(t, "q", goErr.LayoutElem)
+}
+
+func TestConvertParseError(t *testing.T) {
+	_, err := nites.Convert("05-07-2024", "dd/mm/yyyy", "yyyy")
+	utils.AssertEqual(t, `parsing time "05-07-2024" as "dd/mm/yyyy": cannot parse "-07-2024" as "/"`, err.Error())
+	var pe *nites.ParseError
+	utils.AssertEqual(t, true, errors.As(err, &pe))
+	utils.AssertEqual(t, "dd/mm/yyyy", pe.Layout)
+	utils.AssertEqual(t, "/", pe.Token)
+	utils.AssertEqual(t, 2, pe.Offset)
+
+	_, err = nites.Convert("4th July", "dt mmmm", "yyyy")
+	utils.AssertEqual(t, true, errors.As(err, &pe))
+	utils.AssertEqual(t, "dt mmmm", pe.Layout)
+	utils.AssertEqual(t, "4th July", pe.Value)
+	utils.AssertEqual(t, "dt", pe.Token)
+	utils.AssertEqual(t, true, errors.Is(err, nites.ErrOrdinalsNotSupported))
+}
+
+func TestParseErrorSentinels(t *testing.T) {
+	_, err := nites.Parse("dt mmmm", "4th July")
+	utils.AssertEqual(t, true, errors.Is(err, nites.ErrOrdinalsNotSupported))
+
+	_, err = nites.Parse("dd mt", "04 7th")
+	utils.AssertEqual(t, true, errors.Is(err, nites.ErrOrdinalsNotSupported))
+
+	_, err = nites.ParseInLocation("'FY'fyyyy", "FY2026", time.UTC)
+	utils.AssertEqual(t, true, errors.Is(err, nites.ErrFiscalNotSupported))
+
+	_, err = nites.Parse("unix yyyy", "1720512000 2024")
+	utils.AssertEqual(t, true, errors.Is(err, nites.ErrEpochWithFields))
+	utils.AssertEqual(t, `parsing time "1720512000 2024" as "unix yyyy": unix time can only be combined with literal text during parsing; parse the unix time on its own`, err.Error())
+
+	_, err = nites.Parse("dd/mm/yyyy", "5/7/2024")
+	utils.AssertEqual(t, false, errors.Is(err, nites.ErrOrdinalsNotSupported))
+}
@@ -1,8 +1,8 @@
 package nites
 
 import (
-	"fmt"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -18,50 +18,157 @@ import (
 //	"yyyy-mm-dd"     -> "2025-07-07"
 //	"dt of mmm"      -> "7th of Jul"
 //	"mt month"       -> "7th month"
+//	"unixms"         -> "1751846400000"
 //
 // See convertLayout documentation for complete format specification.
 func Format(dt time.Time, layout string) string {
+	return FormatFiscal(dt, layout, dt.Year(), quarterOf(dt.Month()))
+}
+
+// FormatFiscal is like Format but writes the given fiscal year for the fiscal
+// year tokens (fyyyy, fyy) and the given fiscal quarter for the quarter tokens
+// (q, qq). Format uses the calendar year and quarter.
+func FormatFiscal(dt time.Time, layout string, year, quarter int) string {
 	convertedLayouts, _ := convertLayout(layout, false)
 	if str, ok := convertedLayouts.(string); ok {
 		return dt.Format(str)
 	}
 
-	return formatStrs(dt, convertedLayouts.([]string))
+	return formatStrs(dt, convertedLayouts.([]string), year, quarter)
 }
 
 // formatStrs formats a time using multiple layout strings and concatenates the results.
-// This function is used when the layout contains ordinal formats (dt, mt) that require
-// special processing beyond standard Go time formatting.
-func formatStrs(dt time.Time, convertedLayouts []string) string {
-	converted := make([]any, 0, len(convertedLayouts))
-	for _, f := range convertedLayouts {
-
-		ordinalItem := ""
-		ordinalValue := 0
-		switch f {
-		case "dt":
-			ordinalValue = dt.Day()
-			ordinalItem = strconv.Itoa(ordinalValue)
-		case "mt":
-			ordinalValue = int(dt.Month())
-			ordinalItem = strconv.Itoa(ordinalValue)
+// This function is used when the layout contains ordinal (dt, mt) or unix time
+// (unix, unixms) formats, or literal text, that require special processing
+// beyond standard Go time formatting. Go layouts and the special segments
+// alternate, starting with a Go layout. When the layout has an era, years are
+// written as years of the era, so that 1 BC is written as 0001 and not 0000.
+func formatStrs(dt time.Time, convertedLayouts []string, fiscalYear, quarter int) string {
+	bc := false
+	for i := 1; i < len(convertedLayouts); i += 2 {
+		if convertedLayouts[i] == "era" {
+			bc = dt.Year() <= 0
 		}
+	}
 
-		if ordinalItem != "" {
-			switch ordinalValue {
-			case 1, 21, 31:
-				converted = append(converted, ordinalItem+"st")
-			case 2, 22:
-				converted = append(converted, ordinalItem+"nd")
-			case 3, 23:
-				converted = append(converted, ordinalItem+"rd")
-			default:
-				converted = append(converted, ordinalItem+"th")
+	var b strings.Builder
+	for i, f := range convertedLayouts {
+		if i%2 == 0 {
+			if bc {
+				b.WriteString(formatBC(dt, f))
+			} else {
+				b.WriteString(dt.Format(f))
 			}
-		} else {
-			converted = append(converted, dt.Format(f))
+			continue
+		}
+
+		switch {
+		case f == "dt":
+			b.WriteString(ordinal(dt.Day()))
+		case f == "mt":
+			b.WriteString(ordinal(int(dt.Month())))
+		case f == "unix":
+			b.WriteString(strconv.FormatInt(dt.Unix(), 10))
+		case f == "unixms":
+			b.WriteString(strconv.FormatInt(dt.UnixMilli(), 10))
+		case f == "q":
+			b.WriteString(strconv.Itoa(quarter))
+		case f == "qq":
+			b.WriteString("Q" + strconv.Itoa(quarter))
+		case f == "dn":
+			b.WriteString(strconv.Itoa(dt.YearDay()))
+		case f == "ww":
+			b.WriteString(pad(weekOfYear(dt), 2))
+		case f == "wi":
+			_, week := dt.ISOWeek()
+			b.WriteString(pad(week, 2))
+		case f == "fyyyy":
+			b.WriteString(pad(fiscalYear, 4))
+		case f == "fyy":
+			b.WriteString(pad((fiscalYear%100+100)%100, 2))
+		case f == "era":
+			b.WriteString(eraOf(dt.Year()))
+		case f == "ms":
+			b.WriteString(pad(dt.Nanosecond()/1e6, 3))
+		case f == "us":
+			b.WriteString(pad(dt.Nanosecond()/1e3, 6))
+		case f == "ns":
+			b.WriteString(pad(dt.Nanosecond(), 9))
+		case f == "zzzz":
+			b.WriteString(dt.Location().String())
+		case strings.HasPrefix(f, literalSegment):
+			b.WriteString(f[len(literalSegment):])
 		}
 	}
+	return b.String()
+}
+
+// formatBC formats dt, a date BC, with a Go layout, writing its year as a
+// year of the era: 1 - dt.Year().
+func formatBC(dt time.Time, layout string) string {
+	year := 1 - dt.Year()
+	var b, chunk strings.Builder
+	for _, tok := range tokenizeGo(layout) {
+		switch tok.Value {
+		case "yyyy":
+			b.WriteString(dt.Format(chunk.String()))
+			b.WriteString(pad(year, 4))
+			chunk.Reset()
+		case "yy":
+			b.WriteString(dt.Format(chunk.String()))
+			b.WriteString(pad(year%100, 2))
+			chunk.Reset()
+		default:
+			chunk.WriteString(tok.Text)
+		}
+	}
+	b.WriteString(dt.Format(chunk.String()))
+	return b.String()
+}
 
-	return fmt.Sprint(converted...)
+// ordinal returns n with its English ordinal suffix, such as "21st".
+func ordinal(n int) string {
+	item := strconv.Itoa(n)
+	switch n {
+	case 1, 21, 31:
+		return item + "st"
+	case 2, 22:
+		return item + "nd"
+	case 3, 23:
+		return item + "rd"
+	}
+	return item + "th"
+}
+
+// pad returns n with leading zeros to at least width digits.
+func pad(n, width int) string {
+	if n < 0 {
+		return "-" + pad(-n, width)
+	}
+	s := strconv.Itoa(n)
+	if len(s) < width {
+		s = strings.Repeat("0", width-len(s)) + s
+	}
+	return s
+}
+
+// quarterOf returns the quarter of the year that month falls in, 1 to 4.
+func quarterOf(month time.Month) int {
+	return (int(month) + 2) / 3
+}
+
+// weekOfYear returns the week of the year of t, 1 to 54. Weeks start on
+// Sunday and the week of January 1st is week 1.
+func weekOfYear(t time.Time) int {
+	jan1 := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	return (t.YearDay()-1+int(jan1.Weekday()))/7 + 1
+}
+
+// eraOf returns the era of a year in the proleptic Gregorian calendar, in which
+// year 0 is 1 BC.
+func eraOf(year int) string {
+	if year <= 0 {
+		return "BC"
+	}
+	return "AD"
 }
@@ -68,3 +68,96 @@ func BenchmarkFormat(b *testing.B) {
 		nites.Format(date, "yyyy/mm/dd")
 	}
 }
+
+func TestUnixFormatting(t *testing.T) {
+	date := time.Date(2024, 7, 9, 8, 0, 0, 123456789, time.UTC)
+
+	utils.AssertEqual(t, "1720512000", nites.Format(date, "unix"))
+	utils.AssertEqual(t, "1720512000123", nites.Format(date, "unixms"))
+	utils.AssertEqual(t, "#1720512000 (2024-07-09)", nites.Format(date, "#unix (yyyy-mm-dd)"))
+	utils.AssertEqual(t, "-1000", nites.Format(time.UnixMilli(-1000), "unixms"))
+}
+
+func TestFormatQuotedLiterals(t *testing.T) {
+	date := time.Date(2025, 7, 4, 15, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		layout, want string
+	}{
+		{"dt 'of' mmmm", "4th of July"},
+		{"[Week of] mmm d", "Week of Jul 4"},
+		{"hhhh 'o''clock'", "15 o'clock"},
+		{"dd''mm", "04'07"},
+		{"''", "'"},
+		{"'[dd]' [dd]", "[dd] dd"},
+		{`'a\t' \a\t`, `a\t at`},
+		// Quoted text that Go would read as a layout element stays literal.
+		{"'at 3pm' hhhh", "at 3pm 15"},
+		{"'Monday' wwww", "Monday Friday"},
+		{"d'_'d", "4_4"},
+		{"mmm'uary'", "Juluary"},
+		// Quoted and escaped ordinals are literal, next to real ones.
+		{"dt'dt'", "4thdt"},
+		{`dt\d\t`, "4thdt"},
+		{"'mt' mt", "mt 7th"},
+		// A quote or bracket without its pair is an ordinary character.
+		{"yyyy'", "2025'"},
+		{"[yyyy", "[2025"},
+		// Brackets around fields make them literal; escape or quote the
+		// brackets to print them around the fields.
+		{"[yyyy-mm-dd hhhh:ii:ss]", "yyyy-mm-dd hhhh:ii:ss"},
+		{`\[yyyy-mm-dd hhhh:ii:ss\]`, "[2025-07-04 15:05:00]"},
+		{"'['yyyy-mm-dd']'", "[2025-07-04]"},
+		// Paired quotes make a block; double or escape them to print a quote.
+		{"yyyy'mm'dd", "2025mm04"},
+		{"yyyy''mm''dd", "2025'07'04"},
+		{`yyyy\'mm\'dd`, "2025'07'04"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.layout, func(t *testing.T) {
+			utils.AssertEqual(t, tc.want, nites.Format(date, tc.layout))
+		})
+	}
+}
+
+func TestFormatFieldTokens(t *testing.T) {
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	date := time.Date(2025, 7, 4, 15, 5, 7, 123456789, ist)
+
+	tests := []struct {
+		layout, want string
+	}{
+		{"q", "3"},
+		{"qq yyyy", "Q3 2025"},
+		{"dn ddd", "185 185"},
+		{"ww wi", "27 27"},
+		{"fyyyy fyy", "2025 25"},
+		{"era", "AD"},
+		{"ss.ms", "07.123"},
+		{"ss us", "07 123456"},
+		{"ns", "123456789"},
+		{"zzzz zz", "IST IST"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.layout, func(t *testing.T) {
+			utils.AssertEqual(t, tc.want, nites.Format(date, tc.layout))
+		})
+	}
+
+	// Padding and year boundaries.
+	utils.AssertEqual(t, "5 02 01", nites.Format(time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC), "dn ww wi"))
+	utils.AssertEqual(t, "366 53 01", nites.Format(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), "dn ww wi"))
+	utils.AssertEqual(t, "000 000000 000000007", nites.Format(time.Date(2025, 1, 1, 0, 0, 0, 7, time.UTC), "ms us ns"))
+	utils.AssertEqual(t, "BC", nites.Format(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC), "era"))
+
+	// Next to an era, years are years of the era; 1 BC is year 0.
+	utils.AssertEqual(t, "0001 BC", nites.Format(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC), "yyyy era"))
+	utils.AssertEqual(t, "Mar 15, 0044 BC", nites.Format(time.Date(-43, 3, 15, 0, 0, 0, 0, time.UTC), "mmm d, yyyy era"))
+	utils.AssertEqual(t, "44 BC", nites.Format(time.Date(-43, 3, 15, 0, 0, 0, 0, time.UTC), "yy era"))
+	utils.AssertEqual(t, "0025 AD", nites.Format(time.Date(25, 1, 1, 0, 0, 0, 0, time.UTC), "yyyy era"))
+	utils.AssertEqual(t, "-0043", nites.Format(time.Date(-43, 3, 15, 0, 0, 0, 0, time.UTC), "yyyy"))
+	utils.AssertEqual(t, "America/New_York", nites.Format(time.Date(2025, 1, 1, 0, 0, 0, 0, newYork(t)), "zzzz"))
+
+	// The fiscal year and quarter are supplied by the caller.
+	utils.AssertEqual(t, "FY26 Q2", nites.FormatFiscal(date, "'FY'fyy qq", 2026, 2))
+}
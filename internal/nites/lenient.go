@@ -0,0 +1,362 @@
+package nites
+
+import (
+	"strings"
+	"time"
+
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+// Leniency is a set of ways in which ParseLenient may depart from the layout.
+type Leniency uint
+
+const (
+	// LenientDigits accepts fewer digits than a padded token writes, such as
+	// "5" for "dd" or "7" for "ii". Years must have all their digits.
+	LenientDigits Leniency = 1 << iota
+	// LenientCase accepts am/pm markers, eras, quarters, zone abbreviations
+	// and literal text in any case. Month and weekday names are always
+	// matched in any case.
+	LenientCase
+	// LenientNames accepts a full month or weekday name where the layout
+	// has an abbreviation and the other way round, and any abbreviation of
+	// at least three letters, such as "Sept" or "Tues".
+	LenientNames
+	// LenientSeparators accepts any run of spaces and the punctuation "-/.,:"
+	// where the layout has separators, and whitespace around the value.
+	LenientSeparators
+	// LenientSurroundingText ignores text before and after the date, such as
+	// the "Due on " in "Due on 05/07/2024".
+	LenientSurroundingText
+)
+
+var leniencyNames = []string{"digits", "case", "names", "separators", "surrounding text"}
+
+// String returns the names of the leniencies in the set, such as
+// "digits, names", or "none" for the empty set.
+func (l Leniency) String() string {
+	var names []string
+	for i, name := range leniencyNames {
+		if l&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
+
+// separators are the characters LenientSeparators treats as interchangeable.
+const separators = " \t-/.,:"
+
+// ParseLenient parses value with a NITES layout in loc like ParseInLocation.
+// If that fails, the value is matched against the layout again, departing from
+// it only in the allowed ways, rewritten to fit the layout and parsed. The
+// leniencies the value needed are returned; there are none when it fits the
+// layout as is. Errors for a value that was rewritten quote the rewritten value.
+//
+// Example:
+//
+//	t, applied, err := ParseLenient("dd mmm yyyy", "5 Sept 2024", time.UTC, LenientDigits|LenientNames)
+//	// t: 2024-09-05 00:00:00 +0000 UTC, applied: digits, names
+func ParseLenient(layout, value string, loc *time.Location, allowed Leniency) (time.Time, Leniency, error) {
+	parse := func(value string) (time.Time, error) {
+		// Built-in Go layouts such as time.RFC3339 are parsed by Go.
+		if _, ok := utils.BuiltInLayouts[layout]; ok {
+			return time.ParseInLocation(layout, value, loc)
+		}
+		return ParseInLocation(layout, value, loc)
+	}
+
+	t, err := parse(value)
+	if err == nil || allowed == 0 {
+		return t, 0, err
+	}
+
+	rewritten, applied, ok := rewrite(Tokenize(layout), value, allowed)
+	if !ok {
+		return time.Time{}, 0, err
+	}
+	if t, err = parse(rewritten); err != nil {
+		return time.Time{}, 0, err
+	}
+	return t, applied, nil
+}
+
+// rewrite matches value against the tokens of a layout, allowing the given
+// leniencies, and returns the value written the way the layout expects it
+// along with the leniencies that were needed.
+func rewrite(tokens []Token, value string, allowed Leniency) (string, Leniency, bool) {
+	var trimmed Leniency
+	if allowed&LenientSeparators != 0 {
+		if v := strings.TrimSpace(value); v != value {
+			value, trimmed = v, LenientSeparators
+		}
+	}
+
+	for start := 0; start < len(value); start++ {
+		if start > 0 && (allowed&LenientSurroundingText == 0 || joined(value, start)) {
+			continue
+		}
+		out, end, applied, ok := matchTokens(tokens, value, start, allowed)
+		if !ok || end < len(value) && (allowed&LenientSurroundingText == 0 || joined(value, end)) {
+			continue
+		}
+		if start > 0 || end < len(value) {
+			applied |= LenientSurroundingText
+		}
+		return out, applied | trimmed, true
+	}
+	return "", 0, false
+}
+
+// joined reports whether value[i-1] and value[i] are letters or digits of the
+// same word or number, so that value can't be cut between them.
+func joined(value string, i int) bool {
+	return i > 0 && i < len(value) && isAlphanumeric(value[i-1]) && isAlphanumeric(value[i])
+}
+
+func isAlphanumeric(c byte) bool {
+	return isASCIILetter(c) || '0' <= c && c <= '9'
+}
+
+// matchTokens matches the tokens against value from start and returns the
+// matched text rewritten to fit the tokens, the end of the match and the
+// leniencies used.
+func matchTokens(tokens []Token, value string, start int, allowed Leniency) (string, int, Leniency, bool) {
+	var out strings.Builder
+	var applied Leniency
+	p := start
+	for i, tok := range tokens {
+		var text string
+		var n int
+		var used Leniency
+		var ok bool
+		if tok.IsLiteral() {
+			beforeOffset := i+1 < len(tokens) && isOffset(tokens[i+1].Value)
+			n, used, ok = matchLiteral(tok.Text, value[p:], allowed, beforeOffset)
+			text = tok.Text
+		} else {
+			// A padded field directly followed by another field must be
+			// given in full, or the digits would run together.
+			strict := i+1 < len(tokens) && !tokens[i+1].IsLiteral()
+			text, n, used, ok = matchField(tok.Value, value[p:], strict)
+		}
+		if !ok || used&^allowed != 0 {
+			return "", 0, 0, false
+		}
+		out.WriteString(text)
+		applied |= used
+		p += n
+	}
+	return out.String(), p, applied, true
+}
+
+// matchLiteral returns the length of the text at the start of s that matches
+// literal text of a layout. Separators are matched by any run of separators
+// when allowed, except that a sign is left for an offset that follows.
+func matchLiteral(lit, s string, allowed Leniency, beforeOffset bool) (int, Leniency, bool) {
+	var used Leniency
+	p := 0
+	for j := 0; j < len(lit); {
+		if allowed&LenientSeparators != 0 && strings.IndexByte(separators, lit[j]) >= 0 {
+			k := j
+			for k < len(lit) && strings.IndexByte(separators, lit[k]) >= 0 {
+				k++
+			}
+			n := 0
+			for p+n < len(s) && strings.IndexByte(separators, s[p+n]) >= 0 {
+				n++
+			}
+			if k == len(lit) && beforeOffset {
+				for n > 0 && s[p+n-1] == '-' {
+					n--
+				}
+			}
+			if n == 0 {
+				return 0, 0, false
+			}
+			if s[p:p+n] != lit[j:k] {
+				used |= LenientSeparators
+			}
+			j, p = k, p+n
+			continue
+		}
+
+		switch {
+		case p >= len(s):
+			return 0, 0, false
+		case s[p] == lit[j]:
+		case isASCIILetter(s[p]) && strings.EqualFold(s[p:p+1], lit[j:j+1]):
+			used |= LenientCase
+		default:
+			return 0, 0, false
+		}
+		j++
+		p++
+	}
+	return p, used, true
+}
+
+// matchField matches the text at the start of s against a token value and
+// returns it written the way the token writes it, with its length in s and the
+// leniencies used. Strict fields must have all their digits.
+func matchField(value, s string, strict bool) (string, int, Leniency, bool) {
+	switch value {
+	case "yyyy":
+		return matchDigits(s, 4, 4, true)
+	case "yy":
+		return matchDigits(s, 2, 2, true)
+	case "mm", "dd", "hhhh", "hh", "ii", "ss", "ww", "wi":
+		return matchDigits(s, 2, 2, strict)
+	case "ddd":
+		return matchDigits(s, 3, 3, strict)
+	case "m", "d", "db", "h", "i", "s":
+		return matchDigits(s, 0, 2, strict)
+	case "dn":
+		return matchDigits(s, 0, 3, strict)
+	case "q":
+		return matchDigits(s, 1, 1, strict)
+	case "ms":
+		return matchDigits(s, 3, 3, true)
+	case "us":
+		return matchDigits(s, 6, 6, true)
+	case "ns":
+		return matchDigits(s, 9, 9, true)
+	case "mmmm", "mmm":
+		return matchName(s, monthNames, value == "mmmm")
+	case "wwww", "www":
+		return matchName(s, weekdayNames, value == "wwww")
+	case "aa", "a":
+		word := letters(s)
+		if !strings.EqualFold(word, "am") && !strings.EqualFold(word, "pm") {
+			break
+		}
+		text := strings.ToUpper(word)
+		if value == "a" {
+			text = strings.ToLower(word)
+		}
+		return matchCase(text, word)
+	case "era":
+		if word := letters(s); strings.EqualFold(word, "AD") || strings.EqualFold(word, "BC") {
+			return matchCase(strings.ToUpper(word), word)
+		}
+	case "qq":
+		if len(s) > 1 && (s[0] == 'Q' || s[0] == 'q') && '1' <= s[1] && s[1] <= '4' {
+			return matchCase("Q"+s[1:2], s[:2])
+		}
+	case "zz":
+		if word := letters(s); word != "" {
+			return matchCase(strings.ToUpper(word), word)
+		}
+		return matchRun(s, "+-", "0123456789")
+	case "zzzz":
+		return matchRun(s, "", "0123456789_/+-abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	case "unix", "unixms":
+		return matchRun(s, "+-", "0123456789")
+	default:
+		switch {
+		case isOffset(value):
+			if strings.HasPrefix(s, "Z") {
+				return "Z", 1, 0, true
+			}
+			return matchRun(s, "+-", "0123456789:")
+		case value[0] == '0':
+			return matchDigits(s, len(value), len(value), true)
+		case value[0] == '9':
+			return matchDigits(s, 0, 9, true)
+		}
+	}
+	return "", 0, 0, false
+}
+
+// matchDigits matches the run of up to max digits at the start of s, which is
+// padded with zeros to width. Fewer digits than width need LenientDigits and
+// aren't allowed when strict.
+func matchDigits(s string, width, max int, strict bool) (string, int, Leniency, bool) {
+	n := 0
+	for n < len(s) && n < max && '0' <= s[n] && s[n] <= '9' {
+		n++
+	}
+	switch {
+	case n == 0 || strict && n < width:
+		return "", 0, 0, false
+	case n < width:
+		return strings.Repeat("0", width-n) + s[:n], n, LenientDigits, true
+	}
+	return s[:n], n, 0, true
+}
+
+var monthNames = []string{
+	"January", "February", "March", "April", "May", "June",
+	"July", "August", "September", "October", "November", "December",
+}
+
+var weekdayNames = []string{
+	"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+}
+
+// matchName matches the word at the start of s against names, in any case, and
+// returns the full name or its three letter abbreviation. Other abbreviations
+// of at least three letters, and the full name where an abbreviation is
+// expected, need LenientNames.
+func matchName(s string, names []string, full bool) (string, int, Leniency, bool) {
+	word := letters(s)
+	for _, name := range names {
+		want := name
+		if !full {
+			want = name[:3]
+		}
+		switch {
+		case strings.EqualFold(word, want):
+			return want, len(word), 0, true
+		case len(word) >= 3 && len(word) <= len(name) && strings.EqualFold(word, name[:len(word)]):
+			return want, len(word), LenientNames, true
+		}
+	}
+	return "", 0, 0, false
+}
+
+// matchCase returns text for word, which needs LenientCase if they differ.
+func matchCase(text, word string) (string, int, Leniency, bool) {
+	if text != word {
+		return text, len(word), LenientCase, true
+	}
+	return text, len(word), 0, true
+}
+
+// matchRun matches an optional sign from signs followed by a run of chars at
+// the start of s.
+func matchRun(s, signs, chars string) (string, int, Leniency, bool) {
+	n := 0
+	if n < len(s) && strings.IndexByte(signs, s[n]) >= 0 {
+		n++
+	}
+	for n < len(s) && strings.IndexByte(chars, s[n]) >= 0 {
+		n++
+	}
+	if n == 0 {
+		return "", 0, 0, false
+	}
+	return s[:n], n, 0, true
+}
+
+// letters returns the run of ASCII letters at the start of s.
+func letters(s string) string {
+	n := 0
+	for n < len(s) && isASCIILetter(s[n]) {
+		n++
+	}
+	return s[:n]
+}
+
+// isOffset reports whether a token value is a zone offset, such as "ooo" or
+// the Go element "Z07:00".
+func isOffset(value string) bool {
+	switch value {
+	case "o", "oo", "ooo":
+		return true
+	}
+	return value != "" && (value[0] == '-' || value[0] == 'Z')
+}
@@ -0,0 +1,90 @@
+package nites_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2/internal/nites"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestParseLenient(t *testing.T) {
+	all := nites.LenientDigits | nites.LenientCase | nites.LenientNames | nites.LenientSeparators | nites.LenientSurroundingText
+	date := time.Date(2024, 7, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		layout, value string
+		want          time.Time
+		applied       nites.Leniency
+	}{
+		{"dd/mm/yyyy", "05/07/2024", date, 0},
+		{"dd/mm/yyyy", "5/7/2024", date, nites.LenientDigits},
+		{"yyyy-mm-dd hhhh:ii", "2024-07-05 09:5", date.Add(9*time.Hour + 5*time.Minute), nites.LenientDigits},
+		{"mmmm d, yyyy", "JULY 5, 2024", date, 0},
+		{"mmm d, yyyy", "July 5, 2024", date, nites.LenientNames},
+		{"mmmm d, yyyy", "Jul 5, 2024", date, nites.LenientNames},
+		{"dd mmm yyyy", "5 Sept 2024", time.Date(2024, 9, 5, 0, 0, 0, 0, time.UTC), nites.LenientDigits | nites.LenientNames},
+		{"www, dd mmm yyyy", "Fri, 05 Jul 2024", date, 0},
+		{"www dd/mm/yyyy", "Tues 09/07/2024", time.Date(2024, 7, 9, 0, 0, 0, 0, time.UTC), nites.LenientNames},
+		{"h:ii aa", "3:05 pm", time.Date(0, 1, 1, 15, 5, 0, 0, time.UTC), nites.LenientCase},
+		{"yyyy-mm-dd'T'hhhh:ii", "2024-07-05t09:05", date.Add(9*time.Hour + 5*time.Minute), nites.LenientCase},
+		{"qq yyyy", "q3 2024", time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), nites.LenientCase},
+		{"yyyy-mm-dd", "2024/07/05", date, nites.LenientSeparators},
+		{"yyyy-mm-dd", " 2024-07-05\n", date, nites.LenientSeparators},
+		{"dd mmm yyyy", "05  Jul,  2024", date, nites.LenientSeparators},
+		{"yyyy-mm-dd 'at' hhhh:ii", "2024-07-05   AT 09:05", date.Add(9*time.Hour + 5*time.Minute), nites.LenientCase | nites.LenientSeparators},
+		{"yyyy-mm-dd hhhh:ii ooo", "2024-07-05 09:05 -07:00", time.Date(2024, 7, 5, 16, 5, 0, 0, time.UTC), 0},
+		{"yyyy-mm-dd ooo", "2024-07-05, -07:00", time.Date(2024, 7, 5, 7, 0, 0, 0, time.UTC), nites.LenientSeparators},
+		{"yyyymmdd", "2024075", date, nites.LenientDigits},
+		{"dd/mm/yyyy", "Invoice 12 paid on 5/7/2024.", date, nites.LenientDigits | nites.LenientSurroundingText},
+		{"yyyy-mm-dd", "(2024-07-05)", date, nites.LenientSurroundingText},
+		{time.RFC3339, "2024-07-05T09:05:00Z", date.Add(9*time.Hour + 5*time.Minute), 0},
+		{"ss.000", "7.250", time.Date(0, 1, 1, 0, 0, 7, 250000000, time.UTC), nites.LenientDigits},
+	}
+	for _, tc := range tests {
+		t.Run(tc.layout+" "+tc.value, func(t *testing.T) {
+			got, applied, err := nites.ParseLenient(tc.layout, tc.value, time.UTC, all)
+			utils.AssertNoError(t, err)
+			utils.AssertEqual(t, tc.want.UTC(), got.UTC())
+			utils.AssertEqual(t, tc.applied, applied)
+		})
+	}
+}
+
+func TestParseLenientRejects(t *testing.T) {
+	all := nites.LenientDigits | nites.LenientCase | nites.LenientNames | nites.LenientSeparators | nites.LenientSurroundingText
+
+	tests := []struct {
+		layout, value string
+		allowed       nites.Leniency
+		want          string
+	}{
+		// The leniency isn't allowed, so the strict error is returned.
+		{"dd/mm/yyyy", "5/7/2024", nites.LenientNames, `parsing time "5/7/2024" as "dd/mm/yyyy": cannot parse "5/7/2024" as "dd"; "dd" needs two digits; use "d" to accept one`},
+		{"dd mmm yyyy", "05 Sept 2024", nites.LenientDigits, `parsing time "05 Sept 2024" as "dd mmm yyyy": cannot parse "t 2024" as " "`},
+		{"yyyy-mm-dd", "on 2024-07-05", nites.LenientSeparators, `parsing time "on 2024-07-05" as "yyyy-mm-dd": cannot parse "on 2024-07-05" as "yyyy"`},
+		// Digits can't be cut out of a number, nor padded between fields.
+		{"dd/mm/yyyy", "15/07/20245", all, `parsing time "15/07/20245" as "dd/mm/yyyy": extra text: "5"; the value goes on after the end of the layout`},
+		{"yyyymmdd", "202475", all, `parsing time "202475" as "yyyymmdd": month out of range`},
+		{"dd/mm/yyyy", "5/7/24", all, `parsing time "5/7/24" as "dd/mm/yyyy": cannot parse "5/7/24" as "dd"; "dd" needs two digits; use "d" to accept one`},
+		{"mmmm", "Ju", all, `parsing time "Ju" as "mmmm": cannot parse "Ju" as "mmmm"`},
+		// A value that fits leniently is checked like any other.
+		{"dd/mm/yyyy", "31/2/2024", all, `parsing time "31/02/2024" as "dd/mm/yyyy": day out of range`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.layout+" "+tc.value, func(t *testing.T) {
+			_, applied, err := nites.ParseLenient(tc.layout, tc.value, time.UTC, tc.allowed)
+			utils.AssertEqual(t, nites.Leniency(0), applied)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			utils.AssertEqual(t, tc.want, err.Error())
+		})
+	}
+}
+
+func TestLeniencyString(t *testing.T) {
+	utils.AssertEqual(t, "none", nites.Leniency(0).String())
+	utils.AssertEqual(t, "digits, names", (nites.LenientDigits | nites.LenientNames).String())
+	utils.AssertEqual(t, "case, separators, surrounding text", (nites.LenientCase | nites.LenientSeparators | nites.LenientSurroundingText).String())
+}
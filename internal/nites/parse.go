@@ -1,28 +1,25 @@
 package nites
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
 // Parse parses a date string and returns the time value it represents.
 // It accepts a date string and a simple format string such as "yyyy-mm-dd".
 // The layout uses the intuitive date format (IDF) syntax, which is more
-// human-readable than Go's reference time layout.
+// human-readable than Go's reference time layout. Errors are *ParseError
+// values, which describe the failure in terms of the layout.
 //
 // Example:
 //
 //	time, err := Parse("dd-mm-yyyy", "24-01-1984")
 func Parse(layout, value string) (time.Time, error) {
-	convertedFormat, err := convertLayout(layout, true)
-	if err != nil {
-		return time.Time{}, err
-	}
-
-	if str, ok := convertedFormat.(string); ok {
-		return time.Parse(str, value)
-	}
-
-	return time.Time{}, nil
+	t, err := parse(layout, value, time.UTC, time.Parse)
+	return t, newParseError(layout, value, err)
 }
 
 // ParseInLocation parses a date string in the given location and returns the time value.
@@ -34,14 +31,429 @@ func Parse(layout, value string) (time.Time, error) {
 //	loc := time.FixedZone("IST", 5.5*60*60)
 //	time, err := ParseInLocation("dd-mm-yyyy", "24-01-1984", loc)
 func ParseInLocation(layout, value string, loc *time.Location) (time.Time, error) {
+	t, err := parse(layout, value, loc, func(layout, value string) (time.Time, error) {
+		return time.ParseInLocation(layout, value, loc)
+	})
+	return t, newParseError(layout, value, err)
+}
+
+// parse parses value with a NITES layout in loc, using goParse, which is
+// time.Parse or time.ParseInLocation, for Go layouts.
+func parse(layout, value string, loc *time.Location, goParse func(layout, value string) (time.Time, error)) (time.Time, error) {
 	convertedFormat, err := convertLayout(layout, true)
 	if err != nil {
 		return time.Time{}, err
 	}
 
 	if str, ok := convertedFormat.(string); ok {
-		return time.ParseInLocation(str, value, loc)
+		return goParse(str, value)
+	}
+	if strs := convertedFormat.([]string); len(strs) > 1 {
+		return parseSegments(strs, value, loc, goParse)
 	}
 
 	return time.Time{}, nil
 }
+
+// parseSegments parses value with a layout split into segments by convertLayout.
+// Layouts with unix time are parsed by parseEpoch, the others by parseFields
+// using parse, which is time.Parse or time.ParseInLocation.
+func parseSegments(layouts []string, value string, loc *time.Location, parse func(layout, value string) (time.Time, error)) (time.Time, error) {
+	epoch := false
+	for i := 1; i < len(layouts); i += 2 {
+		switch {
+		case isOrdinal(layouts[i]):
+			return time.Time{}, fieldError(layouts[i], ErrOrdinalsNotSupported)
+		case isFiscal(layouts[i]):
+			return time.Time{}, fieldError(layouts[i], ErrFiscalNotSupported)
+		case layouts[i] == "unix" || layouts[i] == "unixms":
+			epoch = true
+		}
+	}
+	if epoch {
+		return parseEpoch(layouts, value, loc)
+	}
+	return parseFields(layouts, value, parse)
+}
+
+// parseEpoch parses value with a layout holding unix time tokens (unix, unixms),
+// as split by convertLayout. The unix time alone determines the instant, so the
+// rest of the layout can only be literal text. The time is returned in loc.
+func parseEpoch(layouts []string, value string, loc *time.Location) (time.Time, error) {
+	var t time.Time
+	rest := value
+	for i, layout := range layouts {
+		if i%2 == 0 {
+			if !isLiteral(layout) {
+				return time.Time{}, fieldError(tokenOf(goLayoutElements(layout)[0]), ErrEpochWithFields)
+			}
+			if !strings.HasPrefix(rest, layout) {
+				return time.Time{}, &ParseError{Token: layout, Offset: len(value) - len(rest), Err: fmt.Errorf("expected %q", layout)}
+			}
+			rest = rest[len(layout):]
+			continue
+		}
+
+		if lit := strings.TrimPrefix(layout, literalSegment); lit != layout {
+			if !strings.HasPrefix(rest, lit) {
+				return time.Time{}, &ParseError{Token: lit, Offset: len(value) - len(rest), Err: fmt.Errorf("expected %q", lit)}
+			}
+			rest = rest[len(lit):]
+			continue
+		}
+
+		n := 0
+		if n < len(rest) && (rest[n] == '-' || rest[n] == '+') {
+			n++
+		}
+		for n < len(rest) && rest[n] >= '0' && rest[n] <= '9' {
+			n++
+		}
+		num, err := strconv.ParseInt(rest[:n], 10, 64)
+		if err != nil {
+			return time.Time{}, &ParseError{Token: layout, Offset: len(value) - len(rest), Err: fmt.Errorf("invalid %s value %q", layout, rest[:n])}
+		}
+		rest = rest[n:]
+
+		switch layout {
+		case "unix":
+			t = time.Unix(num, 0)
+		case "unixms":
+			t = time.UnixMilli(num)
+		default:
+			return time.Time{}, fieldError(layout, ErrEpochWithFields)
+		}
+	}
+	if rest != "" {
+		return time.Time{}, &ParseError{Offset: len(value) - len(rest), Err: fmt.Errorf("extra text %q", rest)}
+	}
+
+	return t.In(loc), nil
+}
+
+// literalPlaceholder stands in for the literal and field segments while Go
+// parses the rest of the layout. Go reads it as plain text that can't join a
+// layout element.
+const literalPlaceholder = "\x00"
+
+// maxFieldAttempts bounds the number of ways parseFields tries to place the
+// segments in a value.
+const maxFieldAttempts = 1000
+
+// parseFields parses value with a layout holding literal text and tokens that
+// Go can't parse, as split by convertLayout. Each segment is looked for in
+// value, in order, and replaced by a placeholder, as it is in the layout,
+// before parse reads the rest; the tokens are then applied to the time that
+// parse returns. When a segment matches at several places, they are tried from
+// the left until the value parses.
+func parseFields(layouts []string, value string, parse func(layout, value string) (time.Time, error)) (time.Time, error) {
+	var goLayout, display strings.Builder
+	var segments []string
+	for i, layout := range layouts {
+		if i%2 == 0 {
+			goLayout.WriteString(layout)
+			display.WriteString(layout)
+			continue
+		}
+		goLayout.WriteString(literalPlaceholder)
+		display.WriteString(strings.TrimPrefix(layout, literalSegment))
+		segments = append(segments, layout)
+	}
+	elems := map[string]bool{}
+	for _, elem := range goLayoutElements(goLayout.String()) {
+		elems[elem] = true
+	}
+
+	var firstErr error
+	var failedWith []string // the text matched by each segment when firstErr occurred
+	var fieldErr error      // the first error from a value that matched the Go layout
+	attempts := 0
+	matched := make([]string, len(segments))
+
+	var try func(k, from int, v string) (time.Time, bool)
+	try = func(k, from int, v string) (time.Time, bool) {
+		if k == len(segments) {
+			attempts++
+			t, err := parse(goLayout.String(), v)
+			if err == nil {
+				if t, err = applyFields(t, segments, matched, elems); err != nil && fieldErr == nil {
+					fieldErr = err
+				}
+				return t, err == nil
+			}
+			if firstErr == nil {
+				firstErr = err
+				failedWith = append([]string(nil), matched...)
+			}
+			return t, err == nil
+		}
+
+		found := false
+		for pos := from; pos <= len(v) && attempts < maxFieldAttempts; pos++ {
+			n := matchSegment(segments[k], v[pos:])
+			if n < 0 {
+				continue
+			}
+			found = true
+			matched[k] = v[pos : pos+n]
+			next := v[:pos] + literalPlaceholder + v[pos+n:]
+			if t, ok := try(k+1, pos+len(literalPlaceholder), next); ok {
+				return t, true
+			}
+		}
+		if !found && firstErr == nil {
+			if lit := strings.TrimPrefix(segments[k], literalSegment); lit != segments[k] {
+				firstErr = fieldError(lit, fmt.Errorf("expected %q", lit))
+			} else {
+				firstErr = fieldError(segments[k], fmt.Errorf("expected a value for %q", segments[k]))
+			}
+		}
+		return time.Time{}, false
+	}
+
+	if t, ok := try(0, 0, value); ok {
+		return t, nil
+	}
+	if fieldErr != nil {
+		return time.Time{}, fieldErr
+	}
+
+	// Report the error in terms of the layout and value as given.
+	var pe *time.ParseError
+	if !errors.As(firstErr, &pe) {
+		return time.Time{}, firstErr
+	}
+	inLayout := make([]string, len(segments))
+	for i, seg := range segments {
+		inLayout[i] = strings.TrimPrefix(seg, literalSegment)
+	}
+	layoutAt := 0
+	if n := strings.Index(goLayout.String(), pe.LayoutElem); n >= 0 {
+		layoutAt = strings.Count(goLayout.String()[:n], literalPlaceholder)
+	}
+	layoutElem := restoreLiterals(pe.LayoutElem, literalPlaceholder, inLayout[layoutAt:])
+	if strings.HasPrefix(pe.LayoutElem, literalPlaceholder) {
+		// Go stopped at a segment; report its token rather than the segment
+		// with the literal text Go joined to it.
+		layoutElem = inLayout[layoutAt]
+	}
+	return time.Time{}, &time.ParseError{
+		Layout:     display.String(),
+		Value:      value,
+		LayoutElem: layoutElem,
+		ValueElem:  restoreLiterals(pe.ValueElem, literalPlaceholder, lastLiterals(pe.ValueElem, literalPlaceholder, failedWith)),
+		// The message quotes the rest of the value, with the placeholder escaped.
+		Message: restoreLiterals(pe.Message, `\x00`, lastLiterals(pe.Message, `\x00`, failedWith)),
+	}
+}
+
+// matchSegment returns the length of the text at the start of s that matches a
+// segment of a converted layout, or -1 if none does.
+func matchSegment(segment, s string) int {
+	if lit := strings.TrimPrefix(segment, literalSegment); lit != segment {
+		if strings.HasPrefix(s, lit) {
+			return len(lit)
+		}
+		return -1
+	}
+
+	digits := func(min, max int) int {
+		n := 0
+		for n < len(s) && n < max && '0' <= s[n] && s[n] <= '9' {
+			n++
+		}
+		if n < min {
+			return -1
+		}
+		return n
+	}
+	switch segment {
+	case "q":
+		if len(s) > 0 && '1' <= s[0] && s[0] <= '4' {
+			return 1
+		}
+	case "qq":
+		if len(s) > 1 && s[0] == 'Q' && '1' <= s[1] && s[1] <= '4' {
+			return 2
+		}
+	case "dn":
+		return digits(1, 3)
+	case "ww", "wi":
+		return digits(2, 2)
+	case "ms":
+		return digits(3, 3)
+	case "us":
+		return digits(6, 6)
+	case "ns":
+		return digits(9, 9)
+	case "era":
+		if strings.HasPrefix(s, "AD") || strings.HasPrefix(s, "BC") {
+			return 2
+		}
+	case "zzzz":
+		n := 0
+		for n < len(s) && (isASCIILetter(s[n]) || strings.IndexByte("0123456789_/+-", s[n]) >= 0) {
+			n++
+		}
+		if n > 0 {
+			return n
+		}
+	}
+	return -1
+}
+
+// applyFields sets the fields that Go couldn't parse on t. Tokens holds the
+// segments of the layout and values the text each of them matched; elems are
+// the Go layout elements that t was parsed with.
+func applyFields(t time.Time, tokens, values []string, elems map[string]bool) (time.Time, error) {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	nsec := t.Nanosecond()
+
+	quarter, yday, week, isoWeek := -1, -1, -1, -1 // not in the layout
+	quarterToken := ""
+	var loc *time.Location
+	for i, token := range tokens {
+		n, _ := strconv.Atoi(strings.TrimPrefix(values[i], "Q"))
+		switch token {
+		case "q", "qq":
+			quarter, quarterToken = n, token
+		case "dn":
+			yday = n
+		case "ww":
+			week = n
+		case "wi":
+			isoWeek = n
+		case "ms":
+			nsec = n * 1e6
+		case "us":
+			nsec = n * 1e3
+		case "ns":
+			nsec = n
+		case "era":
+			if values[i] == "BC" {
+				year = 1 - year
+			}
+		case "zzzz":
+			l, err := time.LoadLocation(values[i])
+			if err != nil {
+				return time.Time{}, fieldError(token, fmt.Errorf("unknown time zone %s", values[i]))
+			}
+			loc = l
+		}
+	}
+
+	hasMonth := elems["January"] || elems["Jan"] || elems["01"] || elems["1"]
+	hasDay := elems["02"] || elems["2"] || elems["_2"] || elems["002"] || elems["__2"]
+	date := func(m time.Month, d int) time.Time { return time.Date(year, m, d, 0, 0, 0, 0, time.UTC) }
+
+	if yday >= 0 {
+		d := date(time.January, yday)
+		if yday < 1 || yday > 366 || d.Year() != year {
+			return time.Time{}, fieldError("dn", errors.New("day-of-year out of range"))
+		}
+		if (hasMonth || hasDay) && (d.Month() != month || d.Day() != day) {
+			return time.Time{}, fieldError("dn", errors.New("day-of-year does not match month and day"))
+		}
+		month, day = d.Month(), d.Day()
+		hasMonth, hasDay = true, true
+	}
+	if week >= 0 {
+		// Week 1 starts on January 1st, the others on Sunday.
+		jan1 := date(time.January, 1)
+		d := jan1
+		if week > 1 {
+			d = jan1.AddDate(0, 0, (week-1)*7-int(jan1.Weekday()))
+		}
+		if week < 1 || d.Year() != year {
+			return time.Time{}, fieldError("ww", errors.New("week out of range"))
+		}
+		if hasMonth || hasDay {
+			if weekOfYear(date(month, day)) != week {
+				return time.Time{}, fieldError("ww", errors.New("week does not match date"))
+			}
+		} else {
+			month, day = d.Month(), d.Day()
+			hasMonth, hasDay = true, true
+		}
+	}
+	if isoWeek >= 0 {
+		// The week of January 4th is the first ISO week.
+		jan4 := date(time.January, 4)
+		d := jan4.AddDate(0, 0, (isoWeek-1)*7-(int(jan4.Weekday())+6)%7)
+		if y, w := d.ISOWeek(); y != year || w != isoWeek {
+			return time.Time{}, fieldError("wi", errors.New("ISO week out of range"))
+		}
+		if hasMonth || hasDay {
+			if _, w := date(month, day).ISOWeek(); w != isoWeek {
+				return time.Time{}, fieldError("wi", errors.New("ISO week does not match date"))
+			}
+		} else {
+			year, month, day = d.Date()
+			hasMonth, hasDay = true, true
+		}
+	}
+	if quarter >= 0 {
+		if hasMonth {
+			if quarterOf(month) != quarter {
+				return time.Time{}, fieldError(quarterToken, errors.New("month does not match quarter"))
+			}
+		} else {
+			month = time.Month(3*quarter - 2)
+		}
+	}
+
+	parsed := time.Date(year, month, day, hour, min, sec, nsec, t.Location())
+	if loc != nil {
+		if hasOffset(elems) {
+			return parsed.In(loc), nil
+		}
+		return time.Date(year, month, day, hour, min, sec, nsec, loc), nil
+	}
+	return parsed, nil
+}
+
+// hasOffset reports whether the Go layout elements include a zone offset or
+// abbreviation.
+func hasOffset(elems map[string]bool) bool {
+	for elem := range elems {
+		if elem == "MST" || strings.HasPrefix(elem, "-07") || strings.HasPrefix(elem, "Z07") {
+			return true
+		}
+	}
+	return false
+}
+
+// lastLiterals returns the texts whose placeholders are in s, the end of a
+// value.
+func lastLiterals(s, placeholder string, texts []string) []string {
+	n := strings.Count(s, placeholder)
+	if n > len(texts) {
+		n = len(texts)
+	}
+	return texts[len(texts)-n:]
+}
+
+// restoreLiterals replaces the placeholders in s with texts, in order.
+func restoreLiterals(s, placeholder string, texts []string) string {
+	for _, text := range texts {
+		if !strings.Contains(s, placeholder) {
+			break
+		}
+		s = strings.Replace(s, placeholder, text, 1)
+	}
+	return s
+}
+
+// literalProbes are two times that share no layout element, used to tell
+// whether a Go layout holds any.
+var literalProbes = [2]time.Time{
+	time.Date(2001, 2, 3, 4, 5, 6, 987654321, time.UTC),
+	time.Date(2012, 11, 24, 15, 16, 17, 0, time.FixedZone("X", 3600)),
+}
+
+// isLiteral reports whether the Go layout is plain text without any layout
+// element.
+func isLiteral(layout string) bool {
+	return literalProbes[0].Format(layout) == layout && literalProbes[1].Format(layout) == layout
+}
@@ -256,3 +256,198 @@ func TestParseInLocationWithOrdinalsShouldError(t *testing.T) {
 		t.Error("Expected error when parsing with both day and month ordinals in location, but got none")
 	}
 }
+
+func TestParseUnix(t *testing.T) {
+	want := time.Date(2024, 7, 9, 8, 0, 0, 0, time.UTC)
+
+	result, err := nites.Parse("unix", "1720512000")
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, want, result)
+	utils.AssertEqual(t, time.UTC, result.Location())
+
+	result, err = nites.Parse("unixms", "1720512000123")
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, want.Add(123*time.Millisecond), result)
+
+	result, err = nites.Parse("@unix", "@-86400")
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC), result)
+
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	result, err = nites.ParseInLocation("(unix)", "(1720512000)", ist)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, want, result)
+	utils.AssertEqual(t, ist, result.Location())
+
+	for _, tc := range []struct{ layout, value string }{
+		{"unix", ""},
+		{"unix", "17205x"},
+		{"unix", "99999999999999999999"},
+		{"@unix", "1720512000"},
+		{"unix yyyy", "1720512000 2024"},
+	} {
+		_, err := nites.Parse(tc.layout, tc.value)
+		if err == nil {
+			t.Errorf("Expected error parsing %q with %q, but got none", tc.value, tc.layout)
+		}
+	}
+
+	// Ordinals are rejected even when the layout was cached by Format.
+	nites.Format(want, "dt unix")
+	_, err = nites.Parse("dt unix", "9th 1720512000")
+	if err == nil {
+		t.Error("Expected error when parsing with an ordinal after formatting, but got none")
+	}
+}
+
+func TestParseQuotedLiterals(t *testing.T) {
+	tests := []struct {
+		layout, value string
+		want          time.Time
+	}{
+		{"wwww, mmmm d, yyyy 'at' h:ii aa", "Friday, July 4, 2025 at 3:05 PM", time.Date(2025, 7, 4, 15, 5, 0, 0, time.UTC)},
+		{"[Day] ddd yyyy", "Day 185 2025", time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC)},
+		{"hhhh 'o''clock'", "15 o'clock", time.Date(0, 1, 1, 15, 0, 0, 0, time.UTC)},
+		{"'at 3pm' yyyy", "at 3pm 2025", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"mmm'uary' yyyy", "Juluary 2025", time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"'1' yyyy '2' mm", "1 2025 2 07", time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)},
+		// The literal also occurs inside a field before it.
+		{"yyyy'0'mm", "2025007", time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"'unix'=yyyy", "unix=2025", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"'#1' unix", "#1 1720512000", time.Unix(1720512000, 0).UTC()},
+		// Escaped brackets around fields, as in a log prefix.
+		{`\[yyyy-mm-dd hhhh:ii:ss\]`, "[2025-07-04 15:05:00]", time.Date(2025, 7, 4, 15, 5, 0, 0, time.UTC)},
+		// Quotes between fields, doubled or escaped.
+		{"yyyy''mm''dd", "2025'07'04", time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC)},
+		{`yyyy\'mm\'dd`, "2025'07'04", time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.layout, func(t *testing.T) {
+			got, err := nites.Parse(tc.layout, tc.value)
+			utils.AssertNoError(t, err)
+			utils.AssertEqual(t, tc.want, got)
+		})
+	}
+
+	// Quoted ordinals are literal text, which parses.
+	got, err := nites.Parse("'dt' dd", "dt 04")
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, 4, got.Day())
+
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	got, err = nites.ParseInLocation("'at 3pm' hhhh", "at 3pm 15", ist)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, time.Date(0, 1, 1, 15, 0, 0, 0, ist), got)
+}
+
+func TestParseQuotedLiteralsErrors(t *testing.T) {
+	tests := []struct {
+		layout, value, want string
+	}{
+		{"'at 3pm' hhhh:ii", "by 3pm 15:05", `parsing time "by 3pm 15:05" as "'at 3pm' hhhh:ii": expected "at 3pm"`},
+		{"'at 3pm' hhhh:ii", "at 3pm 15:xx", `parsing time "at 3pm 15:xx" as "'at 3pm' hhhh:ii": cannot parse "xx" as "ii"`},
+		{"hhhh 'at 3pm' ii", "15 at 3pm 05 extra", `parsing time "15 at 3pm 05 extra" as "hhhh 'at 3pm' ii": extra text: " extra"; the value goes on after the end of the layout`},
+		{"'1' hhhh", "1 xx", `parsing time "1 xx" as "'1' hhhh": cannot parse "xx" as "hhhh"`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.layout, func(t *testing.T) {
+			_, err := nites.Parse(tc.layout, tc.value)
+			utils.AssertEqual(t, tc.want, err.Error())
+		})
+	}
+
+	// Ordinals can't be parsed, even when the layout was converted for
+	// formatting first.
+	nites.Format(time.Now(), "dt 'of' mmmm")
+	_, err := nites.Parse("dt 'of' mmmm", "4th of July")
+	utils.AssertEqual(t, `parsing time "4th of July" as "dt 'of' mmmm": ordinals not supported during parsing; use "d" and remove the suffix, such as the "th" in "4th"`, err.Error())
+}
+
+func newYork(t *testing.T) *time.Location {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+func TestParseFieldTokens(t *testing.T) {
+	ny := newYork(t)
+
+	tests := []struct {
+		layout, value string
+		want          time.Time
+	}{
+		{"qq yyyy", "Q3 2019", time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"yyyy-q", "2019-4", time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"yyyy-mm-dd qq", "2025-07-04 Q3", time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC)},
+		{"yyyy dn", "2025 185", time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC)},
+		{"dn/yyyy", "1/2024", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"yyyy dn", "2024 366", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"yyyy 'week' ww", "2025 week 27", time.Date(2025, 6, 29, 0, 0, 0, 0, time.UTC)},
+		{"yyyy 'week' ww", "2025 week 01", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"yyyy-'W'wi", "2025-W27", time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)},
+		{"yyyy-'W'wi", "2026-W01", time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC)},
+		{"yyyy-mm-dd wi", "2025-07-04 27", time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC)},
+		{"hhhh:ii:ss.ms", "15:05:07.123", time.Date(0, 1, 1, 15, 5, 7, 123000000, time.UTC)},
+		{"ss us", "07 000250", time.Date(0, 1, 1, 0, 0, 7, 250000, time.UTC)},
+		{"ss ns", "07 000000250", time.Date(0, 1, 1, 0, 0, 7, 250, time.UTC)},
+		{"yyyy era", "0044 BC", time.Date(-43, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"yyyy era", "2025 AD", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"yyyy-mm-dd hhhh:ii zzzz", "2025-07-04 15:05 America/New_York", time.Date(2025, 7, 4, 15, 5, 0, 0, ny)},
+		{"yyyy-mm-dd hhhh:ii ooo zzzz", "2025-07-04 15:05 +00:00 America/New_York", time.Date(2025, 7, 4, 11, 5, 0, 0, ny)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.layout+" "+tc.value, func(t *testing.T) {
+			got, err := nites.Parse(tc.layout, tc.value)
+			utils.AssertNoError(t, err)
+			utils.AssertEqual(t, tc.want, got)
+			utils.AssertEqual(t, tc.want.Location().String(), got.Location().String())
+		})
+	}
+
+	// Formatting and parsing round trip.
+	date := time.Date(2025, 7, 4, 15, 5, 7, 123456789, ny)
+	layout := "yyyy-'W'wi dn hhhh:ii:ss.ns zzzz"
+	got, err := nites.Parse(layout, nites.Format(date, layout))
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, date, got)
+
+	// Years BC round trip through the year of the era.
+	for _, year := range []int{0, -43, -999, 1, 2025} {
+		date := time.Date(year, 3, 15, 0, 0, 0, 0, time.UTC)
+		for _, layout := range []string{"yyyy-mm-dd era", "wwww, d mmmm yyyy era"} {
+			got, err := nites.Parse(layout, nites.Format(date, layout))
+			utils.AssertNoError(t, err)
+			utils.AssertEqual(t, date, got)
+		}
+	}
+}
+
+func TestParseFieldTokensErrors(t *testing.T) {
+	tests := []struct {
+		layout, value, want string
+	}{
+		{"yyyy-mm-dd q", "2025-07-04 2", `parsing time "2025-07-04 2" as "yyyy-mm-dd q": month does not match quarter`},
+		{"yyyy dn", "2025 366", `parsing time "2025 366" as "yyyy dn": day-of-year out of range`},
+		{"yyyy dn", "2025 0", `parsing time "2025 0" as "yyyy dn": day-of-year out of range`},
+		{"yyyy-mm-dd dn", "2025-07-04 186", `parsing time "2025-07-04 186" as "yyyy-mm-dd dn": day-of-year does not match month and day`},
+		{"yyyy-'W'wi", "2025-W53", `parsing time "2025-W53" as "yyyy-'W'wi": ISO week out of range`},
+		{"yyyy ww", "2025 55", `parsing time "2025 55" as "yyyy ww": week out of range`},
+		{"yyyy qq", "2025 Q5", `parsing time "2025 Q5" as "yyyy qq": expected a value for "qq"`},
+		{"yyyy zzzz", "2025 Nowhere/Land", `parsing time "2025 Nowhere/Land" as "yyyy zzzz": unknown time zone Nowhere/Land`},
+		{"ss.ms", "07.12", `parsing time "07.12" as "ss.ms": expected a value for "ms"`},
+		{"dn yyyy", "185 20x5", `parsing time "185 20x5" as "dn yyyy": cannot parse "20x5" as "yyyy"`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.layout+" "+tc.value, func(t *testing.T) {
+			_, err := nites.Parse(tc.layout, tc.value)
+			utils.AssertEqual(t, tc.want, err.Error())
+		})
+	}
+
+	_, err := nites.Parse("'FY'fyy", "FY26")
+	utils.AssertEqual(t, `parsing time "FY26" as "'FY'fyy": fiscal years not supported during parsing`, err.Error())
+	_, err = nites.Parse("unix ms", "1720512000 123")
+	utils.AssertEqual(t, `parsing time "1720512000 123" as "unix ms": unix time can only be combined with literal text during parsing; parse the unix time on its own`, err.Error())
+}
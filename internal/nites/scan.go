@@ -0,0 +1,150 @@
+package nites
+
+import "strings"
+
+// conversions maps the first letter of each NITES token to the tokens starting
+// with it and their Go layout equivalents, longest first. Tokens with an empty
+// Go equivalent are handled by Format and Parse themselves.
+var conversions = map[byte][][2]string{
+	'y': {{"yyyy", "2006"}, {"yy", "06"}},
+	'm': {{"mmmm", "January"}, {"mmm", "Jan"}, {"mm", "01"}, {"ms", ""}, {"mt", ""}, {"m", "1"}},
+	'd': {{"ddd", "002"}, {"dd", "02"}, {"db", "_2"}, {"dn", ""}, {"dt", ""}, {"d", "2"}}, // dt for ordinals
+	'w': {{"wwww", "Monday"}, {"www", "Mon"}, {"ww", ""}, {"wi", ""}},
+	'h': {{"hhhh", "15"}, {"hh", "03"}, {"h", "3"}},
+	'a': {{"aa", "PM"}, {"a", "pm"}},
+	'i': {{"ii", "04"}, {"i", "4"}},
+	's': {{"ss", "05"}, {"s", "5"}},
+	'q': {{"qq", ""}, {"q", ""}},
+	'f': {{"fyyyy", ""}, {"fyy", ""}},
+	'e': {{"era", ""}},
+
+	// Fractions of a second and unix time
+	'u': {{"unixms", ""}, {"unix", ""}, {"us", ""}},
+	'n': {{"ns", ""}},
+
+	// Timezone
+	'z': {{"zzzz", ""}, {"zz", "MST"}, {"z", "Z"}},
+	'o': {{"ooo", "-07:00"}, {"oo", "-0700"}, {"o", "-07"}},
+}
+
+// tokenValues maps each NITES token to its Go layout equivalent.
+var tokenValues = func() map[string]string {
+	values := map[string]string{}
+	for _, tokens := range conversions {
+		for _, kv := range tokens {
+			values[kv[0]] = kv[1]
+		}
+	}
+	return values
+}()
+
+// item is a piece of a NITES layout found by scan: a token, or a single
+// literal character.
+type item struct {
+	pos     int    // byte offset in the layout
+	token   string // the NITES token, empty for a literal
+	text    string // the literal character
+	escaped bool   // the literal was escaped or quoted
+}
+
+// scan splits a NITES layout into tokens and literal characters. At each
+// position the longest token wins and any other character is literal. Tokens
+// are lower case. A backslash makes the next character literal, and single
+// quotes or square brackets make a block of text literal. A doubled single
+// quote stands for a quote, inside or outside of a quoted block:
+//
+//	dt \o\f mmmm      ->  4th of July
+//	dt 'of' mmmm     ->  4th of July
+//	[Day] ddd        ->  Day 185
+//	hhhh 'o''clock'  ->  15 o'clock
+//
+// A quote or bracket without its closing pair is an ordinary character. Paired
+// quotes around fields make them literal; to print quotes or brackets around
+// fields, double the quotes or escape them:
+//
+//	yyyy'mm'dd       ->  2025mm04
+//	yyyy''mm''dd     ->  2025'07'04
+//	yyyy\'mm\'dd     ->  2025'07'04
+//	\[yyyy\]         ->  [2025]
+func scan(f string) []item {
+	items := make([]item, 0, len(f))
+	i := 0
+	for i < len(f) {
+		// Check for a quoted block
+		if f[i] == '\'' {
+			if i+1 < len(f) && f[i+1] == '\'' {
+				items = append(items, item{pos: i, text: "'", escaped: true})
+				i += 2
+				continue
+			}
+			if end := closingQuote(f, i+1); end >= 0 {
+				for j := i + 1; j < end; j++ {
+					items = append(items, item{pos: j, text: f[j : j+1], escaped: true})
+					if f[j] == '\'' {
+						j++ // skip the second quote of a pair
+					}
+				}
+				i = end + 1
+				continue
+			}
+		}
+
+		// Check for a bracketed block
+		if f[i] == '[' {
+			if n := strings.IndexByte(f[i+1:], ']'); n >= 0 {
+				for j := i + 1; j <= i+n; j++ {
+					items = append(items, item{pos: j, text: f[j : j+1], escaped: true})
+				}
+				i += n + 2
+				continue
+			}
+		}
+
+		// Check if the current character is an escape character
+		if f[i] == '\\' {
+			if i+1 < len(f) {
+				// The next character is a literal, ignoring its format meaning
+				items = append(items, item{pos: i, text: f[i+1 : i+2], escaped: true})
+				i += 2
+			} else {
+				// We're at the end of the format string; keep the escape character
+				items = append(items, item{pos: i, text: f[i:]})
+				i++
+			}
+			continue
+		}
+
+		// Check for the longest token starting at this character
+		token := ""
+		for _, kv := range conversions[f[i]] {
+			if key := kv[0]; len(f)-i >= len(key) && f[i:i+len(key)] == key {
+				token = key
+				break
+			}
+		}
+		if token == "" {
+			items = append(items, item{pos: i, text: f[i : i+1]})
+			i++
+			continue
+		}
+		items = append(items, item{pos: i, token: token})
+		i += len(token)
+	}
+	return items
+}
+
+// closingQuote returns the index of the quote that closes a quoted block whose
+// text starts at f[i], or -1 if the block isn't closed.
+func closingQuote(f string, i int) int {
+	for ; i < len(f); i++ {
+		if f[i] != '\'' {
+			continue
+		}
+		if i+1 < len(f) && f[i+1] == '\'' {
+			i++
+			continue
+		}
+		return i
+	}
+	return -1
+}
@@ -0,0 +1,208 @@
+package nites
+
+import "strings"
+
+// Token is a piece of a layout. Value names the element in a vocabulary shared
+// by all dialects: the NITES token when NITES has one (such as "yyyy" or "dt"),
+// the Go layout element otherwise (such as "Z07:00"), or the digits of a
+// fractional second (such as "000" or "999"). Value is empty for literal text.
+// Text is the piece as written in the source layout.
+type Token struct {
+	Value string
+	Text  string
+}
+
+// IsLiteral reports whether the token is literal text.
+func (t Token) IsLiteral() bool {
+	return t.Value == ""
+}
+
+// isFraction reports whether the token is a fractional second.
+func (t Token) isFraction() bool {
+	return t.Value != "" && (t.Value[0] == '0' || t.Value[0] == '9')
+}
+
+// Tokenize splits a NITES layout into tokens. Anything that isn't a NITES token
+// is passed on to Go's time package by Format and Parse, so the rest of the
+// layout is read as a Go layout: ".000" is a fractional second and a stray
+// "2006" is a year, exactly as they behave when formatting. Escaped and quoted
+// text is literal.
+func Tokenize(layout string) []Token {
+	converted, _ := convertLayout(layout, false) // never fails when not parsing
+
+	var tokens []Token
+	switch v := converted.(type) {
+	case string:
+		tokens = tokenizeGo(v)
+	case []string:
+		for i, s := range v {
+			if i%2 == 0 {
+				tokens = appendTokens(tokens, tokenizeGo(s)...)
+			} else if strings.HasPrefix(s, literalSegment) {
+				tokens = appendTokens(tokens, Token{Text: s[len(literalSegment):]})
+			} else {
+				tokens = appendTokens(tokens, Token{Value: s, Text: s})
+			}
+		}
+	}
+
+	// Report the elements the way they were written, as NITES tokens.
+	for i, t := range tokens {
+		if _, ok := nitesTokens[t.Value]; ok {
+			tokens[i].Text = t.Value
+		}
+	}
+	return tokens
+}
+
+// appendTokens appends tokens to dst, merging adjacent literal text.
+func appendTokens(dst []Token, tokens ...Token) []Token {
+	for _, t := range tokens {
+		if t.IsLiteral() && len(dst) > 0 && dst[len(dst)-1].IsLiteral() {
+			dst[len(dst)-1].Text += t.Text
+			continue
+		}
+		dst = append(dst, t)
+	}
+	return dst
+}
+
+// goElements maps the elements of Go layouts to token values.
+var goElements = map[string]string{
+	"January":   "mmmm",
+	"Jan":       "mmm",
+	"Monday":    "wwww",
+	"Mon":       "www",
+	"MST":       "zz",
+	"01":        "mm",
+	"02":        "dd",
+	"03":        "hh",
+	"04":        "ii",
+	"05":        "ss",
+	"06":        "yy",
+	"002":       "ddd",
+	"15":        "hhhh",
+	"1":         "m",
+	"2006":      "yyyy",
+	"2":         "d",
+	"_2":        "db",
+	"__2":       "__2",
+	"3":         "h",
+	"4":         "i",
+	"5":         "s",
+	"PM":        "aa",
+	"pm":        "a",
+	"-070000":   "-070000",
+	"-07:00:00": "-07:00:00",
+	"-0700":     "oo",
+	"-07:00":    "ooo",
+	"-07":       "o",
+	"Z070000":   "Z070000",
+	"Z07:00:00": "Z07:00:00",
+	"Z0700":     "Z0700",
+	"Z07:00":    "Z07:00",
+	"Z07":       "Z07",
+}
+
+// tokenizeGo splits a Go layout into tokens, following the rules of the time
+// package.
+func tokenizeGo(layout string) []Token {
+	var tokens []Token
+	for i := 0; i < len(layout); {
+		elem := goElementAt(layout, i)
+		if elem == "" {
+			tokens = appendTokens(tokens, Token{Text: layout[i : i+1]})
+			i++
+			continue
+		}
+		if c := elem[0]; c == '.' || c == ',' {
+			// A fractional second; its separator is literal text.
+			tokens = appendTokens(tokens, Token{Text: elem[:1]}, Token{Value: elem[1:], Text: elem[1:]})
+		} else {
+			tokens = appendTokens(tokens, Token{Value: goElements[elem], Text: elem})
+		}
+		i += len(elem)
+	}
+	return tokens
+}
+
+// goElementAt returns the Go layout element that starts at layout[i], or "" if
+// none does.
+func goElementAt(layout string, i int) string {
+	rest := layout[i:]
+	hasPrefix := func(p string) bool { return len(rest) >= len(p) && rest[:len(p)] == p }
+	lowerAfter := func(n int) bool { return len(rest) > n && 'a' <= rest[n] && rest[n] <= 'z' }
+
+	switch rest[0] {
+	case 'J':
+		if hasPrefix("January") {
+			return "January"
+		}
+		if hasPrefix("Jan") && !lowerAfter(3) {
+			return "Jan"
+		}
+	case 'M':
+		if hasPrefix("Monday") {
+			return "Monday"
+		}
+		if hasPrefix("Mon") && !lowerAfter(3) {
+			return "Mon"
+		}
+		if hasPrefix("MST") {
+			return "MST"
+		}
+	case '0':
+		if len(rest) >= 2 && '1' <= rest[1] && rest[1] <= '6' {
+			return rest[:2]
+		}
+		if hasPrefix("002") {
+			return "002"
+		}
+	case '1':
+		if hasPrefix("15") {
+			return "15"
+		}
+		return "1"
+	case '2':
+		if hasPrefix("2006") {
+			return "2006"
+		}
+		return "2"
+	case '_':
+		if hasPrefix("_2") && !hasPrefix("_2006") {
+			return "_2"
+		}
+		if hasPrefix("__2") {
+			return "__2"
+		}
+	case '3', '4', '5':
+		return rest[:1]
+	case 'P':
+		if hasPrefix("PM") {
+			return "PM"
+		}
+	case 'p':
+		if hasPrefix("pm") {
+			return "pm"
+		}
+	case '-', 'Z':
+		for _, suffix := range []string{"070000", "07:00:00", "0700", "07:00", "07"} {
+			if p := rest[:1] + suffix; hasPrefix(p) {
+				return p
+			}
+		}
+	case '.', ',':
+		// A run of the same digit is a fractional second only if no other
+		// digit follows it.
+		if len(rest) >= 2 && (rest[1] == '0' || rest[1] == '9') {
+			j := 1
+			for j < len(rest) && rest[j] == rest[1] {
+				j++
+			}
+			if j == len(rest) || rest[j] < '0' || rest[j] > '9' {
+				return rest[:j]
+			}
+		}
+	}
+	return ""
+}
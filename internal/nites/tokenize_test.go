@@ -0,0 +1,40 @@
+package nites_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2/internal/nites"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestTokenize(t *testing.T) {
+	tok := func(v string) nites.Token { return nites.Token{Value: v, Text: v} }
+	lit := func(s string) nites.Token { return nites.Token{Text: s} }
+
+	tests := []struct {
+		layout string
+		want   []nites.Token
+	}{
+		{"yyyy-mm-dd", []nites.Token{tok("yyyy"), lit("-"), tok("mm"), lit("-"), tok("dd")}},
+		{"dt mmmm, yyyy", []nites.Token{tok("dt"), lit(" "), tok("mmmm"), lit(", "), tok("yyyy")}},
+		{"hhhh:ii:ss.000", []nites.Token{tok("hhhh"), lit(":"), tok("ii"), lit(":"), tok("ss"), lit("."), tok("000")}},
+		{"ss,999999 ooo", []nites.Token{tok("ss"), lit(","), tok("999999"), lit(" "), tok("ooo")}},
+		{`\d\a\y d`, []nites.Token{lit("day "), tok("d")}},
+		{"'day' [of] d", []nites.Token{lit("day of "), tok("d")}},
+		{"'at 3pm' hhhh", []nites.Token{lit("at 3pm "), tok("hhhh")}},
+		{"unixms", []nites.Token{tok("unixms")}},
+		{"qq fyyyy era", []nites.Token{tok("qq"), lit(" "), tok("fyyyy"), lit(" "), tok("era")}},
+		{"ss.ms us ns", []nites.Token{tok("ss"), lit("."), tok("ms"), lit(" "), tok("us"), lit(" "), tok("ns")}},
+		{"dn ww wi zzzz", []nites.Token{tok("dn"), lit(" "), tok("ww"), lit(" "), tok("wi"), lit(" "), tok("zzzz")}},
+		// Go layout elements in the text keep their meaning.
+		{"yyyy 2006", []nites.Token{tok("yyyy"), lit(" "), tok("yyyy")}},
+		{"ssZ07:00", []nites.Token{tok("ss"), {Value: "Z07:00", Text: "Z07:00"}}},
+		{time.Kitchen, []nites.Token{tok("h"), lit(":"), tok("ii"), tok("aa")}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.layout, func(t *testing.T) {
+			utils.AssertEqual(t, tc.want, nites.Tokenize(tc.layout))
+		})
+	}
+}
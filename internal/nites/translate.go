@@ -0,0 +1,508 @@
+package nites
+
+import (
+	"strings"
+)
+
+// Dialect identifies a layout syntax that Translate reads and writes.
+type Dialect int
+
+const (
+	// DialectNITES is the NITES syntax, such as "yyyy-mm-dd".
+	DialectNITES Dialect = iota
+	// DialectGo is Go's reference time syntax, such as "2006-01-02".
+	DialectGo
+	// DialectStrftime is the C and Python strftime syntax, such as "%Y-%m-%d".
+	DialectStrftime
+	// DialectJava is the Java DateTimeFormatter and ICU syntax, such as
+	// "yyyy-MM-dd".
+	DialectJava
+	// DialectMoment is the Moment.js syntax, such as "YYYY-MM-DD".
+	DialectMoment
+)
+
+// String returns the name of the dialect.
+func (d Dialect) String() string {
+	switch d {
+	case DialectNITES:
+		return "NITES"
+	case DialectGo:
+		return "Go"
+	case DialectStrftime:
+		return "strftime"
+	case DialectJava:
+		return "Java"
+	case DialectMoment:
+		return "Moment"
+	}
+	return "unknown"
+}
+
+// Translate rewrites layout from one dialect to another. It returns the
+// translated layout and, when some parts of the layout have no equivalent in
+// the target dialect, those parts as written in the source layout. The
+// translated layout is only meaningful when nothing is returned as missing.
+func Translate(layout string, from, to Dialect) (string, []string) {
+	if from == to {
+		return layout, nil
+	}
+
+	var tokens []Token
+	switch from {
+	case DialectNITES:
+		tokens = Tokenize(layout)
+	case DialectGo:
+		tokens = tokenizeGo(layout)
+	case DialectStrftime:
+		tokens = tokenizeStrftime(layout)
+	case DialectJava:
+		tokens = tokenizeJava(layout)
+	case DialectMoment:
+		tokens = tokenizeMoment(layout)
+	default:
+		return "", []string{layout}
+	}
+	return render(tokens, to)
+}
+
+// nitesTokens maps token values to NITES tokens. Every value NITES can write is
+// its own NITES token.
+var nitesTokens = map[string]string{
+	"yyyy": "yyyy", "yy": "yy",
+	"mmmm": "mmmm", "mmm": "mmm", "mm": "mm", "mt": "mt", "m": "m",
+	"ddd": "ddd", "dd": "dd", "db": "db", "dt": "dt", "d": "d",
+	"wwww": "wwww", "www": "www",
+	"hhhh": "hhhh", "hh": "hh", "h": "h",
+	"aa": "aa", "a": "a",
+	"ii": "ii", "i": "i",
+	"ss": "ss", "s": "s",
+	"zzzz": "zzzz", "zz": "zz", "ooo": "ooo", "oo": "oo", "o": "o",
+	"unix": "unix", "unixms": "unixms",
+	"dn": "dn", "ww": "ww", "wi": "wi", "qq": "qq", "q": "q",
+	"fyyyy": "fyyyy", "fyy": "fyy", "era": "era",
+	"ms": "ms", "us": "us", "ns": "ns",
+}
+
+// goTokens maps token values to Go layout elements.
+var goTokens = invert(goElements)
+
+// strftimeTokens maps strftime directives to token values. The "-" flag, which
+// drops padding, is a glibc and BSD extension, as are %e, %P, %s and %:z.
+var strftimeTokens = map[string]string{
+	"%Y": "yyyy", "%y": "yy",
+	"%B": "mmmm", "%b": "mmm", "%h": "mmm", "%m": "mm", "%-m": "m",
+	"%j": "ddd", "%-j": "dn", "%d": "dd", "%e": "db", "%-d": "d", "%V": "wi",
+	"%A": "wwww", "%a": "www",
+	"%H": "hhhh", "%I": "hh", "%-I": "h",
+	"%p": "aa", "%P": "a",
+	"%M": "ii", "%-M": "i",
+	"%S": "ss", "%-S": "s",
+	"%f": "000000",
+	"%Z": "zz", "%z": "oo", "%:z": "ooo",
+	"%s": "unix",
+}
+
+// strftimeComposites are the strftime directives that stand for several others.
+var strftimeComposites = map[string]string{
+	"%F": "%Y-%m-%d",
+	"%T": "%H:%M:%S",
+	"%D": "%m/%d/%y",
+	"%R": "%H:%M",
+	"%r": "%I:%M:%S %p",
+}
+
+// strftimeLiterals are the strftime directives that write literal text.
+var strftimeLiterals = map[string]string{"%%": "%", "%n": "\n", "%t": "\t"}
+
+// javaTokens maps Java pattern letters to token values. Runs of "S" are
+// fractional seconds, and "ppd", a space padded day, is read as a whole.
+var javaTokens = map[string]string{
+	"yyyy": "yyyy", "uuuu": "yyyy", "yy": "yy", "uu": "yy",
+	"MMMM": "mmmm", "LLLL": "mmmm", "MMM": "mmm", "LLL": "mmm",
+	"MM": "mm", "LL": "mm", "M": "m", "L": "m",
+	"DDD": "ddd", "D": "dn", "dd": "dd", "ppd": "db", "d": "d",
+	"Q": "q", "QQQ": "qq", "G": "era", "VV": "zzzz",
+	"EEEE": "wwww", "EEE": "www", "EE": "www", "E": "www",
+	"HH": "hhhh", "hh": "hh", "h": "h", "a": "aa",
+	"mm": "ii", "m": "i", "ss": "ss", "s": "s",
+	"zzz": "zz", "zz": "zz", "z": "zz",
+	"xxx": "ooo", "xx": "oo", "x": "o",
+	"ZZZ": "oo", "ZZ": "oo", "Z": "oo", "ZZZZZ": "Z07:00",
+	"XXX": "Z07:00", "XX": "Z0700", "X": "Z07",
+}
+
+// momentTokens maps Moment.js tokens to token values. Runs of "S" are
+// fractional seconds.
+var momentTokens = map[string]string{
+	"YYYY": "yyyy", "YY": "yy",
+	"MMMM": "mmmm", "MMM": "mmm", "MM": "mm", "Mo": "mt", "M": "m",
+	"DDDD": "ddd", "DDD": "dn", "DD": "dd", "Do": "dt", "D": "d",
+	"Q": "q", "WW": "wi", "N": "era", "NN": "era", "NNN": "era",
+	"dddd": "wwww", "ddd": "www",
+	"HH": "hhhh", "hh": "hh", "h": "h",
+	"A": "aa", "a": "a",
+	"mm": "ii", "m": "i", "ss": "ss", "s": "s",
+	"z": "zz", "zz": "zz", "Z": "ooo", "ZZ": "oo",
+	"X": "unix", "x": "unixms",
+}
+
+// momentUnsupported are the Moment.js tokens without an equivalent in the
+// other dialects. They are recognized so that they are reported rather than
+// read as literal text.
+var momentUnsupported = []string{
+	"YYYYYY", "YYYYY", "Y", "gggg", "gg", "GGGG", "GG", "Qo",
+	"DDDo", "do", "dd", "d", "wo", "ww", "w", "Wo", "W",
+	"e", "E", "H", "kk", "k", "NNNNN", "NNNN",
+	"LTS", "LT", "LLLL", "LLL", "LL", "L", "llll", "lll", "ll", "l",
+}
+
+// unsupported prefixes the value of a token that a dialect has but the others
+// don't, so that it can't be taken for a token of the same spelling in another
+// dialect, such as Java's week "ww" for the NITES week "ww".
+const unsupported = "?"
+
+// Preferred spellings when a dialect has several for the same value.
+var (
+	strftimeRender = preferred(strftimeTokens, "%b")
+	javaRender     = preferred(javaTokens, "yyyy", "yy", "MMMM", "MMM", "MM", "M", "EEE", "z", "xx")
+	momentRender   = preferred(momentTokens, "z", "N")
+)
+
+// invert returns the map from values to keys of m, which must be one to one.
+func invert(m map[string]string) map[string]string {
+	inv := make(map[string]string, len(m))
+	for k, v := range m {
+		inv[v] = k
+	}
+	return inv
+}
+
+// preferred inverts m, which may map several keys to the same value, picking
+// the given keys for those values.
+func preferred(m map[string]string, keys ...string) map[string]string {
+	inv := make(map[string]string, len(m))
+	for k, v := range m {
+		if _, ok := inv[v]; !ok || k < inv[v] {
+			inv[v] = k
+		}
+	}
+	for _, k := range keys {
+		inv[m[k]] = k
+	}
+	return inv
+}
+
+// tokenizeStrftime splits a strftime format into tokens.
+func tokenizeStrftime(layout string) []Token {
+	var tokens []Token
+	for i := 0; i < len(layout); {
+		if layout[i] != '%' {
+			tokens = appendTokens(tokens, Token{Text: layout[i : i+1]})
+			i++
+			continue
+		}
+
+		j := i + 1
+		if j < len(layout) && (layout[j] == '-' || layout[j] == ':') {
+			j++
+		}
+		if j >= len(layout) {
+			tokens = appendTokens(tokens, Token{Value: layout[i:], Text: layout[i:]})
+			break
+		}
+		directive := layout[i : j+1]
+		i = j + 1
+
+		if lit, ok := strftimeLiterals[directive]; ok {
+			tokens = appendTokens(tokens, Token{Text: lit})
+			continue
+		}
+		if expanded, ok := strftimeComposites[directive]; ok {
+			for _, t := range tokenizeStrftime(expanded) {
+				if !t.IsLiteral() {
+					t.Text = directive
+				}
+				tokens = appendTokens(tokens, t)
+			}
+			continue
+		}
+		value, ok := strftimeTokens[directive]
+		if !ok {
+			value = unsupported + directive
+		}
+		tokens = appendTokens(tokens, Token{Value: value, Text: directive})
+	}
+	return tokens
+}
+
+// tokenizeJava splits a Java DateTimeFormatter pattern into tokens.
+func tokenizeJava(layout string) []Token {
+	var tokens []Token
+	for i := 0; i < len(layout); {
+		c := layout[i]
+		switch {
+		case c == '\'':
+			if strings.HasPrefix(layout[i:], "''") {
+				tokens = appendTokens(tokens, Token{Text: "'"})
+				i += 2
+				continue
+			}
+			// Quoted text runs to the next lone quote; '' inside it is a quote.
+			var lit strings.Builder
+			j := i + 1
+			for ; j < len(layout); j++ {
+				if layout[j] == '\'' {
+					if j+1 < len(layout) && layout[j+1] == '\'' {
+						lit.WriteByte('\'')
+						j++
+						continue
+					}
+					break
+				}
+				lit.WriteByte(layout[j])
+			}
+			if j >= len(layout) {
+				tokens = appendTokens(tokens, Token{Value: layout[i:], Text: layout[i:]})
+				return tokens
+			}
+			tokens = appendTokens(tokens, Token{Text: lit.String()})
+			i = j + 1
+
+		case isASCIILetter(c):
+			j := i
+			for j < len(layout) && layout[j] == c {
+				j++
+			}
+			run := layout[i:j]
+			if run == "pp" && strings.HasPrefix(layout[j:], "d") && !strings.HasPrefix(layout[j:], "dd") {
+				tokens = appendTokens(tokens, Token{Value: "db", Text: "ppd"})
+				i = j + 1
+				continue
+			}
+			value, ok := javaTokens[run]
+			if c == 'S' {
+				value, ok = strings.Repeat("0", len(run)), len(run) <= 9
+			}
+			if !ok {
+				value = unsupported + run
+			}
+			tokens = appendTokens(tokens, Token{Value: value, Text: run})
+			i = j
+
+		case strings.IndexByte("[]{}#", c) >= 0:
+			tokens = appendTokens(tokens, Token{Value: layout[i : i+1], Text: layout[i : i+1]})
+			i++
+
+		default:
+			tokens = appendTokens(tokens, Token{Text: layout[i : i+1]})
+			i++
+		}
+	}
+	return tokens
+}
+
+// tokenizeMoment splits a Moment.js format into tokens.
+func tokenizeMoment(layout string) []Token {
+	var tokens []Token
+	for i := 0; i < len(layout); {
+		if layout[i] == '[' {
+			if end := strings.IndexByte(layout[i:], ']'); end > 0 {
+				tokens = appendTokens(tokens, Token{Text: layout[i+1 : i+end]})
+				i += end + 1
+				continue
+			}
+		}
+
+		if layout[i] == 'S' {
+			j := i
+			for j < len(layout) && layout[j] == 'S' && j-i < 9 {
+				j++
+			}
+			tokens = appendTokens(tokens, Token{Value: strings.Repeat("0", j-i), Text: layout[i:j]})
+			i = j
+			continue
+		}
+
+		// Moment picks the longest token at each position.
+		match := ""
+		for tok := range momentTokens {
+			if len(tok) > len(match) && strings.HasPrefix(layout[i:], tok) {
+				match = tok
+			}
+		}
+		for _, tok := range momentUnsupported {
+			if len(tok) > len(match) && strings.HasPrefix(layout[i:], tok) {
+				match = tok
+			}
+		}
+		if match == "" {
+			tokens = appendTokens(tokens, Token{Text: layout[i : i+1]})
+			i++
+			continue
+		}
+
+		value, ok := momentTokens[match]
+		if !ok {
+			value = unsupported + match
+		}
+		tokens = appendTokens(tokens, Token{Value: value, Text: match})
+		i += len(match)
+	}
+	return tokens
+}
+
+// render writes tokens in the dialect, returning the parts of the source
+// layout that it can't write.
+func render(tokens []Token, to Dialect) (string, []string) {
+	var out strings.Builder
+	var missing []string
+	miss := func(text string) {
+		for _, m := range missing {
+			if m == text {
+				return
+			}
+		}
+		missing = append(missing, text)
+	}
+
+	for _, t := range tokens {
+		if t.IsLiteral() {
+			if lit, ok := renderLiteral(t.Text, to); ok {
+				out.WriteString(lit)
+			} else {
+				miss(t.Text)
+			}
+			continue
+		}
+
+		if t.isFraction() {
+			if frac, ok := renderFraction(t.Value, out.String(), to); ok {
+				out.WriteString(frac)
+			} else {
+				miss(t.Text)
+			}
+			continue
+		}
+
+		var table map[string]string
+		switch to {
+		case DialectNITES:
+			table = nitesTokens
+		case DialectGo:
+			table = goTokens
+		case DialectStrftime:
+			table = strftimeRender
+		case DialectJava:
+			table = javaRender
+		case DialectMoment:
+			table = momentRender
+		}
+		if tok, ok := table[t.Value]; ok {
+			out.WriteString(tok)
+		} else {
+			miss(t.Text)
+		}
+	}
+	return out.String(), missing
+}
+
+// renderFraction writes a fractional second of the given digits in the dialect.
+// NITES and Go need a '.' or ',' right before it.
+func renderFraction(digits, before string, to Dialect) (string, bool) {
+	switch to {
+	case DialectNITES, DialectGo:
+		if strings.HasSuffix(before, ".") || strings.HasSuffix(before, ",") {
+			return digits, true
+		}
+	case DialectStrftime:
+		if digits == "000000" {
+			return "%f", true
+		}
+	case DialectJava, DialectMoment:
+		if digits[0] == '0' {
+			return strings.Repeat("S", len(digits)), true
+		}
+	}
+	return "", false
+}
+
+// renderLiteral writes literal text in the dialect, quoting or escaping it as
+// needed. It fails when the dialect can't write the text.
+func renderLiteral(text string, to Dialect) (string, bool) {
+	switch to {
+	case DialectNITES:
+		// Token letters and the escape characters are quoted as a single span.
+		// Quoted text is kept from Go, so text that Go would read as a layout
+		// element, such as "3pm", is quoted as well.
+		misread := !isLiteral(text)
+		if !misread && strings.IndexFunc(text, func(r rune) bool {
+			return r < 0x80 && (conversionStarts(byte(r)) || strings.ContainsRune(`\'[`, r))
+		}) < 0 {
+			return text, true
+		}
+		first, last := quoteSpan(text, func(c byte) bool {
+			return isASCIILetter(c) || '0' <= c && c <= '9' || strings.IndexByte(`\'[`, c) >= 0 ||
+				misread && strings.IndexByte("_-.,", c) >= 0
+		})
+		if strings.Trim(text[first:last], "'") == "" {
+			return text[:first] + strings.ReplaceAll(text[first:last], "'", "''") + text[last:], true
+		}
+		return text[:first] + "'" + strings.ReplaceAll(text[first:last], "'", "''") + "'" + text[last:], true
+
+	case DialectGo:
+		return text, isLiteral(text)
+
+	case DialectStrftime:
+		return strings.ReplaceAll(text, "%", "%%"), true
+
+	case DialectJava:
+		// Letters and the reserved characters are quoted as a single span.
+		first, last := quoteSpan(text, func(c byte) bool {
+			return isASCIILetter(c) || strings.IndexByte("[]{}#", c) >= 0
+		})
+		if first < 0 {
+			return strings.ReplaceAll(text, "'", "''"), true
+		}
+		return strings.ReplaceAll(text[:first], "'", "''") +
+			"'" + strings.ReplaceAll(text[first:last], "'", "''") + "'" +
+			strings.ReplaceAll(text[last:], "'", "''"), true
+
+	case DialectMoment:
+		// Letters are bracketed as a single span; brackets can't be escaped.
+		if strings.ContainsAny(text, "[]") {
+			return "", false
+		}
+		first, last := quoteSpan(text, isASCIILetter)
+		if first < 0 {
+			return text, true
+		}
+		return text[:first] + "[" + text[first:last] + "]" + text[last:], true
+	}
+	return "", false
+}
+
+// quoteSpan returns the span of text from the first to the last byte that needs
+// quoting, or -1 and -1 when none does.
+func quoteSpan(text string, needsQuote func(byte) bool) (first, last int) {
+	first, last = -1, -1
+	for i := 0; i < len(text); i++ {
+		if needsQuote(text[i]) {
+			if first < 0 {
+				first = i
+			}
+			last = i + 1
+		}
+	}
+	return first, last
+}
+
+// conversionStarts reports whether c starts a NITES token.
+func conversionStarts(c byte) bool {
+	_, ok := conversions[c]
+	return ok
+}
+
+// isASCIILetter reports whether c is an ASCII letter.
+func isASCIILetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
@@ -0,0 +1,124 @@
+package nites_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2/internal/nites"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		layout   string
+		from, to nites.Dialect
+		want     string
+	}{
+		// From NITES.
+		{"yyyy-mm-dd hhhh:ii:ss", nites.DialectNITES, nites.DialectGo, "2006-01-02 15:04:05"},
+		{"yyyy-mm-dd hhhh:ii:ss", nites.DialectNITES, nites.DialectStrftime, "%Y-%m-%d %H:%M:%S"},
+		{"yyyy-mm-dd hhhh:ii:ss", nites.DialectNITES, nites.DialectJava, "yyyy-MM-dd HH:mm:ss"},
+		{"yyyy-mm-dd hhhh:ii:ss", nites.DialectNITES, nites.DialectMoment, "YYYY-MM-DD HH:mm:ss"},
+		{"wwww, d mmmm yy h:i a", nites.DialectNITES, nites.DialectStrftime, "%A, %-d %B %y %-I:%-M %P"},
+		{"www, dt mmm, h aa", nites.DialectNITES, nites.DialectMoment, "ddd, Do MMM, h A"},
+		{"yyyy-mm-ddThhhh:ii:ss.000ooo", nites.DialectNITES, nites.DialectJava, "yyyy-MM-dd'T'HH:mm:ss.SSSxxx"},
+		{"yyyy-mm-ddThhhh:ii:ss.000ooo", nites.DialectNITES, nites.DialectMoment, "YYYY-MM-DD[T]HH:mm:ss.SSSZ"},
+		{"hhhh:ii:ss.000000 oo zz", nites.DialectNITES, nites.DialectStrftime, "%H:%M:%S.%f %z %Z"},
+		{"ddd db", nites.DialectNITES, nites.DialectJava, "DDD ppd"},
+		{"unix unixms", nites.DialectNITES, nites.DialectMoment, "X x"},
+		{`\a\t 100%`, nites.DialectNITES, nites.DialectStrftime, "at %-m00%%"},
+		{"yyyy-dn 'W'wi", nites.DialectNITES, nites.DialectStrftime, "%Y-%-j W%V"},
+		{"qq yyyy era zzzz", nites.DialectNITES, nites.DialectJava, "QQQ yyyy G VV"},
+		{"q dn wi era", nites.DialectNITES, nites.DialectMoment, "Q DDD WW N"},
+
+		// Into NITES.
+		{"Mon, 02 Jan 2006 15:04:05 MST", nites.DialectGo, nites.DialectNITES, "www, dd mmm yyyy hhhh:ii:ss zz"},
+		{time.Kitchen, nites.DialectGo, nites.DialectNITES, "h:iiaa"},
+		{"%d/%m/%Y %I:%M %p", nites.DialectStrftime, nites.DialectNITES, "dd/mm/yyyy hh:ii aa"},
+		{"%F %T", nites.DialectStrftime, nites.DialectNITES, "yyyy-mm-dd hhhh:ii:ss"},
+		{"%H:%M:%S.%f", nites.DialectStrftime, nites.DialectNITES, "hhhh:ii:ss.000000"},
+		{"EEEE, MMMM d, uuuu 'at' h:mm a", nites.DialectJava, nites.DialectNITES, "wwww, mmmm d, yyyy 'at' h:ii aa"},
+		{"HH 'o''clock'", nites.DialectJava, nites.DialectNITES, "hhhh 'o''clock'"},
+		{"dddd, MMMM Do YYYY, h:mm:ss a", nites.DialectMoment, nites.DialectNITES, "wwww, mmmm dt yyyy, h:ii:ss a"},
+		{"[Week of] MMM D", nites.DialectMoment, nites.DialectNITES, "'Week of' mmm d"},
+		{"100%% on %a", nites.DialectStrftime, nites.DialectNITES, "'100% on' www"},
+		{"[[x] D", nites.DialectMoment, nites.DialectNITES, `'[x' d`},
+		{"%-j %V", nites.DialectStrftime, nites.DialectNITES, "dn wi"},
+		{"D QQQ G VV", nites.DialectJava, nites.DialectNITES, "dn qq era zzzz"},
+		{"DDD Q WW NN", nites.DialectMoment, nites.DialectNITES, "dn q wi era"},
+
+		// Between other dialects.
+		{"2006-01-02T15:04:05.000Z07:00", nites.DialectGo, nites.DialectJava, "yyyy-MM-dd'T'HH:mm:ss.SSSXXX"},
+		{"%Y-%m-%dT%H:%M:%S%z", nites.DialectStrftime, nites.DialectGo, "2006-01-02T15:04:05-0700"},
+		{"[Today is] dddd", nites.DialectMoment, nites.DialectJava, "'Today is' EEEE"},
+		{"h 'o''clock'", nites.DialectJava, nites.DialectMoment, "h [o'clock]"},
+		{"ppd/MM", nites.DialectJava, nites.DialectGo, "_2/01"},
+
+		// The same dialect is returned as is.
+		{"%Q", nites.DialectStrftime, nites.DialectStrftime, "%Q"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.layout+" "+tc.to.String(), func(t *testing.T) {
+			got, missing := nites.Translate(tc.layout, tc.from, tc.to)
+			utils.AssertEqual(t, 0, len(missing))
+			utils.AssertEqual(t, tc.want, got)
+		})
+	}
+}
+
+func TestTranslateMissing(t *testing.T) {
+	tests := []struct {
+		layout   string
+		from, to nites.Dialect
+		missing  []string
+	}{
+		{"dt mt yyyy", nites.DialectNITES, nites.DialectStrftime, []string{"dt", "mt"}},
+		{"dt mt yyyy", nites.DialectNITES, nites.DialectGo, []string{"dt", "mt"}},
+		{"dt unixms", nites.DialectNITES, nites.DialectJava, []string{"dt", "unixms"}},
+		{"h:ii a", nites.DialectNITES, nites.DialectJava, []string{"a"}},
+		{"ss.999", nites.DialectNITES, nites.DialectMoment, []string{"999"}},
+		{"ss.000", nites.DialectNITES, nites.DialectStrftime, []string{"000"}},
+		{"2006-01-02T15:04:05Z07:00", nites.DialectGo, nites.DialectNITES, []string{"Z07:00"}},
+		// Literal text that Go reads as a layout element.
+		{"%S%f", nites.DialectStrftime, nites.DialectGo, []string{"%f"}},
+		{"%U %W %U", nites.DialectStrftime, nites.DialectNITES, []string{"%U", "%W"}},
+		{"%", nites.DialectStrftime, nites.DialectNITES, []string{"%"}},
+		{"YYYY-'W'ww-e", nites.DialectJava, nites.DialectNITES, []string{"YYYY", "ww", "e"}},
+		{"yyyy[-MM]", nites.DialectJava, nites.DialectNITES, []string{"[", "]"}},
+		{"yyyy 'open", nites.DialectJava, nites.DialectNITES, []string{"'open"}},
+		{"Qo YYYY wo", nites.DialectMoment, nites.DialectNITES, []string{"Qo", "wo"}},
+		{"dd D", nites.DialectMoment, nites.DialectNITES, []string{"dd"}},
+		{"HH", nites.DialectMoment, nites.DialectNITES, nil},
+		{"H:mm", nites.DialectMoment, nites.DialectGo, []string{"H"}},
+		{"yyyy Z", nites.DialectNITES, nites.DialectMoment, nil},
+		{"[a]", nites.DialectStrftime, nites.DialectMoment, []string{"[a]"}},
+		{"yyyy", nites.Dialect(99), nites.DialectNITES, []string{"yyyy"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.layout+" "+tc.to.String(), func(t *testing.T) {
+			_, missing := nites.Translate(tc.layout, tc.from, tc.to)
+			utils.AssertEqual(t, tc.missing, missing)
+		})
+	}
+}
+
+func TestTranslateFormatsAlike(t *testing.T) {
+	date := time.Date(2025, 7, 4, 9, 5, 7, 123456789, time.FixedZone("IST", 5*60*60+30*60))
+
+	// A NITES layout and its Go translation format every time the same way.
+	for _, layout := range []string{
+		"yyyy-mm-dd hhhh:ii:ss.000000 ooo",
+		"wwww, d mmmm yy h:i:s aa zz",
+		"www db mmm ddd oo o",
+		`\d\a\y d`,
+		time.RFC1123Z,
+	} {
+		goLayout, missing := nites.Translate(layout, nites.DialectNITES, nites.DialectGo)
+		utils.AssertEqual(t, 0, len(missing))
+		utils.AssertEqual(t, nites.Format(date, layout), date.Format(goLayout))
+
+		back, missing := nites.Translate(goLayout, nites.DialectGo, nites.DialectNITES)
+		utils.AssertEqual(t, 0, len(missing))
+		utils.AssertEqual(t, nites.Format(date, layout), nites.Format(date, back))
+	}
+}
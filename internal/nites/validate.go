@@ -0,0 +1,253 @@
+package nites
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+// IssueKind classifies a problem found by Validate.
+type IssueKind int
+
+const (
+	// IssueUnknownToken is a run of letters that isn't a NITES token and is
+	// written to the output as is, such as "yyy" or "YYYY".
+	IssueUnknownToken IssueKind = iota + 1
+	// IssueMonthAsMinute is a month token used where a minute is expected, such
+	// as the "mm" in "hh:mm".
+	IssueMonthAsMinute
+	// IssueMinuteAsMonth is a minute token used where a month is expected, such
+	// as the "ii" in "yyyy-ii-dd".
+	IssueMinuteAsMonth
+	// IssueMissingMeridiem is a 12-hour clock without an am/pm token.
+	IssueMissingMeridiem
+	// IssueDuplicateField is a field given more than once, such as a year in
+	// both "yyyy" and "yy".
+	IssueDuplicateField
+	// IssueMergedToken is a multi-letter token that older versions read as
+	// separate tokens or text, written right next to other tokens or letters,
+	// such as the "ms" in "hms", which was the hour, the month and the second.
+	IssueMergedToken
+)
+
+// String returns a short name for the kind, such as "unknown token".
+func (k IssueKind) String() string {
+	switch k {
+	case IssueUnknownToken:
+		return "unknown token"
+	case IssueMonthAsMinute:
+		return "month as minute"
+	case IssueMinuteAsMonth:
+		return "minute as month"
+	case IssueMissingMeridiem:
+		return "missing am/pm"
+	case IssueDuplicateField:
+		return "duplicate field"
+	case IssueMergedToken:
+		return "merged token"
+	}
+	return "unknown"
+}
+
+// Issue is a likely mistake in a NITES layout. Pos is the byte offset of Text in
+// the layout.
+type Issue struct {
+	Pos     int
+	Text    string
+	Kind    IssueKind
+	Message string
+}
+
+// fields maps the tokens that give a date or time field to the field's name.
+var fields = map[string]string{
+	"yyyy": "year", "yy": "year",
+	"mmmm": "month", "mmm": "month", "mm": "month", "mt": "month", "m": "month",
+	"dd": "day", "db": "day", "dt": "day", "d": "day",
+	"ddd": "day of year", "dn": "day of year",
+	"wwww": "weekday", "www": "weekday",
+	"ww": "week", "wi": "ISO week",
+	"q": "quarter", "qq": "quarter",
+	"fyyyy": "fiscal year", "fyy": "fiscal year",
+	"era":  "era",
+	"hhhh": "hour", "hh": "hour", "h": "hour",
+	"aa": "am/pm", "a": "am/pm",
+	"ii": "minute", "i": "minute",
+	"ss": "second", "s": "second",
+	"ms": "fraction of a second", "us": "fraction of a second", "ns": "fraction of a second",
+	"zz": "zone", "zzzz": "zone name",
+	"ooo": "offset", "oo": "offset", "o": "offset",
+	"unixms": "unix time", "unix": "unix time",
+}
+
+// mergedTokens maps the multi-letter tokens that older versions read as
+// separate tokens or text to what they are now and what they were.
+var mergedTokens = map[string][2]string{
+	"ms":     {"milliseconds", "the month and the second"},
+	"us":     {"microseconds", `a "u" and the second`},
+	"ns":     {"nanoseconds", `an "n" and the second`},
+	"dn":     {"the day of the year", `the day and an "n"`},
+	"ww":     {"the week of the year", `the text "ww"`},
+	"era":    {"the era", `the text "er" and am/pm`},
+	"zzzz":   {"the zone name", "the zone abbreviation twice"},
+	"q":      {"the quarter", `the text "q"`},
+	"qq":     {"the quarter", `the text "qq"`},
+	"wi":     {"the ISO week", `a "w" and the minute`},
+	"fyy":    {"the fiscal year", `an "f" and the two-digit year`},
+	"fyyyy":  {"the fiscal year", `an "f" and the year`},
+	"unix":   {"unix time", `the text "un", the minute and an "x"`},
+	"unixms": {"unix time in milliseconds", `the text "un", the minute, an "x", the month and the second`},
+}
+
+// Validate reports likely mistakes in a NITES layout: letters that aren't
+// tokens and would be written as is, months where minutes are meant and the
+// other way round, 12-hour clocks without am/pm, fields given twice, and new
+// multi-letter tokens run together with other tokens. The issues are sorted
+// by position. Built-in Go layouts such as time.RFC3339 have
+// no issues.
+func Validate(layout string) []Issue {
+	if version, ok := utils.BuiltInLayouts[layout]; ok && utils.RuntimeVersion >= version {
+		return nil
+	}
+
+	items := scan(layout)
+	var issues []Issue
+	reported := map[int]bool{} // items that already have an issue
+
+	issues = append(issues, unknownTokens(layout, items)...)
+
+	hasMeridiem := false
+	for _, it := range items {
+		if it.token == "a" || it.token == "aa" {
+			hasMeridiem = true
+		}
+	}
+
+	seen := map[string]item{}
+	twelveHour := -1
+	for i, it := range items {
+		switch it.token {
+		case "":
+			continue
+		case "m", "mm":
+			if follows(items, i, ":", "h", "hh", "hhhh") || precedes(items, i, ":", "s", "ss") {
+				issues = append(issues, Issue{it.pos, it.token, IssueMonthAsMinute,
+					fmt.Sprintf("%q is the month; use %q for minutes", it.token, strings.Repeat("i", len(it.token)))})
+				reported[i] = true
+			}
+		case "i", "ii":
+			if follows(items, i, "-/.", "yyyy", "yy", "dd", "db", "d") || precedes(items, i, "-/.", "yyyy", "yy", "dd", "db", "d") {
+				issues = append(issues, Issue{it.pos, it.token, IssueMinuteAsMonth,
+					fmt.Sprintf("%q is the minute; use %q for the month", it.token, strings.Repeat("m", len(it.token)))})
+				reported[i] = true
+			}
+		case "h", "hh":
+			if twelveHour < 0 {
+				twelveHour = i
+			}
+		}
+
+		if merged, ok := mergedTokens[it.token]; ok && (touches(items, i-1) || touches(items, i+1)) {
+			issues = append(issues, Issue{it.pos, it.token, IssueMergedToken,
+				fmt.Sprintf("%q is %s; older versions read it as %s; separate it from the tokens next to it", it.token, merged[0], merged[1])})
+		}
+
+		field, ok := fields[it.token]
+		if !ok || reported[i] {
+			continue
+		}
+		if first, ok := seen[field]; ok {
+			issues = append(issues, Issue{it.pos, it.token, IssueDuplicateField,
+				fmt.Sprintf("%q repeats the %s given by %q", it.token, field, first.token)})
+			continue
+		}
+		seen[field] = it
+	}
+
+	if twelveHour >= 0 && !hasMeridiem {
+		it := items[twelveHour]
+		issues = append(issues, Issue{it.pos, it.token, IssueMissingMeridiem,
+			fmt.Sprintf("%q is a 12-hour clock but the layout has no am/pm token; add \"aa\" or use \"hhhh\"", it.token)})
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool { return issues[i].Pos < issues[j].Pos })
+	return issues
+}
+
+// unknownTokens reports runs of literal letters. A letter is allowed on its own
+// when it is upper case, like the "T" in "yyyy-mm-ddThhhh". A run includes the
+// tokens of the same letter next to it, so "yyy" is reported as a whole.
+func unknownTokens(layout string, items []item) []Issue {
+	isLetter := func(i int) bool {
+		return i >= 0 && i < len(items) && items[i].token == "" && !items[i].escaped && isASCIILetter(items[i].text[0])
+	}
+
+	var issues []Issue
+	for i := 0; i < len(items); i++ {
+		if !isLetter(i) {
+			continue
+		}
+		j := i
+		for isLetter(j + 1) {
+			j++
+		}
+		if c := items[i].text[0]; i == j && 'A' <= c && c <= 'Z' {
+			continue
+		}
+
+		// Take in the tokens of the same letter on either side.
+		start, end := i, j
+		if start > 0 && items[start-1].token != "" && items[start-1].token[0] == items[i].text[0] {
+			start--
+		}
+		if end+1 < len(items) && items[end+1].token != "" && items[end+1].token[0] == items[j].text[0] {
+			end++
+		}
+		from := items[start].pos
+		to := len(layout)
+		if end+1 < len(items) {
+			to = items[end+1].pos
+		}
+		text := layout[from:to]
+		issues = append(issues, Issue{from, text, IssueUnknownToken,
+			fmt.Sprintf("unknown token %q; quote literal text, such as 'at'", text)})
+		i = j
+	}
+	return issues
+}
+
+// follows reports whether items[i] comes right after one of the tokens, with
+// exactly one of the separators between them.
+func follows(items []item, i int, seps string, tokens ...string) bool {
+	return i >= 2 && isSeparator(items[i-1], seps) && hasToken(items[i-2], tokens)
+}
+
+// precedes reports whether items[i] comes right before one of the tokens, with
+// exactly one of the separators between them.
+func precedes(items []item, i int, seps string, tokens ...string) bool {
+	return i+2 < len(items) && isSeparator(items[i+1], seps) && hasToken(items[i+2], tokens)
+}
+
+// touches reports whether items[i] is a token or an unquoted letter, which
+// would run together with a token next to it.
+func touches(items []item, i int) bool {
+	if i < 0 || i >= len(items) {
+		return false
+	}
+	it := items[i]
+	return it.token != "" || !it.escaped && isASCIILetter(it.text[0])
+}
+
+func isSeparator(it item, seps string) bool {
+	return it.token == "" && strings.Contains(seps, it.text)
+}
+
+func hasToken(it item, tokens []string) bool {
+	for _, t := range tokens {
+		if it.token == t {
+			return true
+		}
+	}
+	return false
+}
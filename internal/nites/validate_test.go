@@ -0,0 +1,103 @@
+package nites_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2/internal/nites"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestValidate(t *testing.T) {
+	type issue struct {
+		pos  int
+		text string
+		kind nites.IssueKind
+	}
+
+	tests := []struct {
+		layout string
+		want   []issue
+	}{
+		{"yyyy-mm-dd hhhh:ii:ss", nil},
+		{"yyyy-mm-ddThhhh:ii:ssZ", nil},
+		{"dt mmmm, yyyy hh:ii aa", nil},
+		{`\d\a\y d`, nil},
+		{"dt 'of' mmmm [Week]", nil},
+		{"'FY'fyy qq, yyyy-'W'wi dn era", nil},
+		{"hhhh:ii:ss.ms zzzz", nil},
+		{"q qq", []issue{{2, "qq", nites.IssueDuplicateField}}},
+		{"ss.ms.us", []issue{{6, "us", nites.IssueDuplicateField}}},
+		{"yyy-mm-dd", []issue{{0, "yyy", nites.IssueUnknownToken}}},
+		{"YYYY-MM-DD", []issue{
+			{0, "YYYY", nites.IssueUnknownToken},
+			{5, "MM", nites.IssueUnknownToken},
+			{8, "DD", nites.IssueUnknownToken},
+		}},
+		{"dd de mmmm", []issue{
+			{3, "d", nites.IssueDuplicateField},
+			{4, "e", nites.IssueUnknownToken},
+		}},
+		{"hhhh:mm", []issue{{5, "mm", nites.IssueMonthAsMinute}}},
+		{"mm:ss", []issue{{0, "mm", nites.IssueMonthAsMinute}}},
+		{"yyyy-ii-dd", []issue{{5, "ii", nites.IssueMinuteAsMonth}}},
+		{"hh:ii", []issue{{0, "hh", nites.IssueMissingMeridiem}}},
+		{"yyyy yy", []issue{{5, "yy", nites.IssueDuplicateField}}},
+		{"dd/mm/yyyy hh:mm", []issue{
+			{11, "hh", nites.IssueMissingMeridiem},
+			{14, "mm", nites.IssueMonthAsMinute},
+		}},
+		{"mm/dd ms", nil},
+		{"hms", []issue{
+			{0, "h", nites.IssueMissingMeridiem},
+			{1, "ms", nites.IssueMergedToken},
+		}},
+		{"yyyymmddms", []issue{{8, "ms", nites.IssueMergedToken}}},
+		{"dnn", []issue{
+			{0, "dn", nites.IssueMergedToken},
+			{2, "n", nites.IssueUnknownToken},
+		}},
+		{"zzzzzz", []issue{{0, "zzzz", nites.IssueMergedToken}}},
+		{"ssus", []issue{{2, "us", nites.IssueMergedToken}}},
+		{"wii", []issue{{0, "wi", nites.IssueMergedToken}}},
+		{"yyyyqq", []issue{{4, "qq", nites.IssueMergedToken}}},
+		{"Qq yyyy", []issue{{1, "q", nites.IssueMergedToken}}},
+		{"fyyyymm", []issue{{0, "fyyyy", nites.IssueMergedToken}}},
+		{"fyyww", []issue{
+			{0, "fyy", nites.IssueMergedToken},
+			{3, "ww", nites.IssueMergedToken},
+		}},
+		{"unixdd", []issue{{0, "unix", nites.IssueMergedToken}}},
+		{"'@'unixms", nil},
+		{"unixmsdd", []issue{{0, "unixms", nites.IssueMergedToken}}},
+		{"hhhh:ii:ss'ns'", nil},
+		{time.RFC3339, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.layout, func(t *testing.T) {
+			var got []issue
+			for _, is := range nites.Validate(tc.layout) {
+				got = append(got, issue{is.Pos, is.Text, is.Kind})
+			}
+			utils.AssertEqual(t, tc.want, got)
+		})
+	}
+}
+
+func TestValidateMessages(t *testing.T) {
+	tests := []struct {
+		layout, want string
+	}{
+		{"yyy", `unknown token "yyy"; quote literal text, such as 'at'`},
+		{"hhhh:mm", `"mm" is the month; use "ii" for minutes`},
+		{"yyyy/i/d", `"i" is the minute; use "m" for the month`},
+		{"h:ii", `"h" is a 12-hour clock but the layout has no am/pm token; add "aa" or use "hhhh"`},
+		{"wwww www", `"www" repeats the weekday given by "wwww"`},
+		{"hhhhms", `"ms" is milliseconds; older versions read it as the month and the second; separate it from the tokens next to it`},
+	}
+	for _, tc := range tests {
+		issues := nites.Validate(tc.layout)
+		utils.AssertEqual(t, 1, len(issues))
+		utils.AssertEqual(t, tc.want, issues[0].Message)
+	}
+}
@@ -0,0 +1,283 @@
+package gotime
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidPeriod is returned when a string is not a valid ISO 8601 duration.
+var ErrInvalidPeriod = errors.New("invalid ISO 8601 duration")
+
+// Period is an ISO 8601 duration such as "P1Y2M10DT2H30M". The date part is
+// counted in calendar units, whose length depends on when they are applied,
+// while the time part is an exact duration.
+//
+// Components may be negative, and the zero value is an empty period.
+type Period struct {
+	Years  int
+	Months int
+	Weeks  int
+	Days   int
+
+	// Time is the exact part of the period (the components after "T").
+	Time time.Duration
+}
+
+// ParsePeriod parses an ISO 8601 duration such as "P1Y2M10DT2H30M", "PT36H" or
+// "P3W". The time components may have a decimal fraction ("PT1.5H", "PT0,25S").
+// A leading sign negates the whole period ("-P1D") and components may carry
+// their own sign ("P1M-1D").
+//
+// Example:
+//
+//	p, err := gotime.ParsePeriod("P1Y2M10DT2H30M")
+//	// p: Period{Years: 1, Months: 2, Days: 10, Time: 2*time.Hour + 30*time.Minute}
+func ParsePeriod(s string) (Period, error) {
+	invalid := func() (Period, error) {
+		return Period{}, fmt.Errorf("%w: %q", ErrInvalidPeriod, s)
+	}
+
+	rest := s
+	negate := false
+	if rest != "" && (rest[0] == '-' || rest[0] == '+') {
+		negate = rest[0] == '-'
+		rest = rest[1:]
+	}
+	if rest == "" || (rest[0] != 'P' && rest[0] != 'p') {
+		return invalid()
+	}
+	rest = rest[1:]
+
+	var p Period
+	inTime := false
+	seen := 0
+	order := ""
+	for rest != "" {
+		if rest[0] == 'T' || rest[0] == 't' {
+			if inTime {
+				return invalid()
+			}
+			inTime = true
+			order = ""
+			rest = rest[1:]
+			if rest == "" {
+				return invalid()
+			}
+			continue
+		}
+
+		// Read a signed decimal number followed by a designator.
+		i := 0
+		if rest[i] == '-' || rest[i] == '+' {
+			i++
+		}
+		for i < len(rest) && (rest[i] >= '0' && rest[i] <= '9' || rest[i] == '.' || rest[i] == ',') {
+			i++
+		}
+		if i == len(rest) {
+			return invalid()
+		}
+		number := strings.Replace(rest[:i], ",", ".", 1)
+		designator := strings.ToUpper(rest[i : i+1])
+		rest = rest[i+1:]
+
+		// Designators must appear at most once and in order.
+		units := "YMWD"
+		if inTime {
+			units = "HMS"
+		}
+		pos := strings.Index(units, designator)
+		if pos < 0 || strings.ContainsAny(order, units[pos:]) {
+			return invalid()
+		}
+		order += designator
+		seen++
+
+		if inTime {
+			unit := map[string]time.Duration{"H": time.Hour, "M": time.Minute, "S": time.Second}[designator]
+			d, ok := parseDecimalDuration(number, unit)
+			if !ok {
+				return invalid()
+			}
+			p.Time += d
+			continue
+		}
+
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return invalid()
+		}
+		switch designator {
+		case "Y":
+			p.Years = n
+		case "M":
+			p.Months = n
+		case "W":
+			p.Weeks = n
+		case "D":
+			p.Days = n
+		}
+	}
+	if seen == 0 {
+		return invalid()
+	}
+	if negate {
+		p = p.Negate()
+	}
+	return p, nil
+}
+
+// parseDecimalDuration converts a signed decimal number of units to a duration.
+func parseDecimalDuration(number string, unit time.Duration) (time.Duration, bool) {
+	whole, frac := number, ""
+	if i := strings.IndexByte(number, '.'); i >= 0 {
+		whole, frac = number[:i], number[i+1:]
+		if frac == "" || strings.ContainsAny(frac, "+-.") {
+			return 0, false
+		}
+	}
+	negative := strings.HasPrefix(whole, "-")
+	whole = strings.TrimLeft(whole, "+-")
+	if whole == "" && frac == "" {
+		return 0, false
+	}
+
+	var d time.Duration
+	if whole != "" {
+		n, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil || n > int64(1<<63-1)/int64(unit) {
+			return 0, false
+		}
+		d = time.Duration(n) * unit
+	}
+	// Add the fraction digit by digit so that it stays exact down to the nanosecond.
+	scale := unit
+	for _, c := range frac {
+		scale /= 10
+		d += time.Duration(c-'0') * scale
+	}
+	if negative {
+		d = -d
+	}
+	return d, true
+}
+
+// IsZero reports whether every component of p is zero.
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Negate returns p with every component negated.
+func (p Period) Negate() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Weeks: -p.Weeks, Days: -p.Days, Time: -p.Time}
+}
+
+// Normalize returns p with whole years carried out of the months and weeks folded
+// into days, so that "P14M3W" becomes "P1Y2M21D". The time part is kept as it
+// is, because a day is not always 24 hours long.
+func (p Period) Normalize() Period {
+	months := p.Years*12 + p.Months
+	return Period{
+		Years:  months / 12,
+		Months: months % 12,
+		Days:   p.Weeks*7 + p.Days,
+		Time:   p.Time,
+	}
+}
+
+// AddTo returns t moved by the period. The date part is added in calendar
+// units, keeping the wall clock time like time.AddDate does (a time skipped by
+// a DST transition moves forward by the length of the gap), and the time part
+// is then added as an exact duration.
+//
+// Example:
+//
+//	p, _ := gotime.ParsePeriod("P1M")
+//	t := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+//	p.AddTo(t) // 2025-02-15 09:00:00 +0000 UTC
+func (p Period) AddTo(t time.Time) time.Time {
+	if p.Years != 0 || p.Months != 0 || p.Weeks != 0 || p.Days != 0 {
+		t, _ = DateInLocation(t.Location(),
+			t.Year()+p.Years, int(t.Month())+p.Months, t.Day()+p.Weeks*7+p.Days,
+			t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), DSTCompatible)
+	}
+	return t.Add(p.Time)
+}
+
+// Duration returns the exact length of the period when it is applied to the
+// given anchor time. The same period can have different lengths at different
+// anchors: "P1M" is 31 days in January and 28 days in February 2025.
+//
+// Example:
+//
+//	p, _ := gotime.ParsePeriod("P1M")
+//	p.Duration(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)) // 672h0m0s
+func (p Period) Duration(anchor time.Time) time.Duration {
+	return p.AddTo(anchor).Sub(anchor)
+}
+
+// String returns the ISO 8601 form of the period, such as "P1Y2M10DT2H30M".
+// The zero period is "PT0S", and a period whose components are all negative is
+// written with a leading minus sign, such as "-P1D".
+func (p Period) String() string {
+	if p.IsZero() {
+		return "PT0S"
+	}
+	if p.Years <= 0 && p.Months <= 0 && p.Weeks <= 0 && p.Days <= 0 && p.Time <= 0 {
+		return "-" + p.Negate().String()
+	}
+
+	var b strings.Builder
+	b.WriteByte('P')
+	for _, c := range []struct {
+		n          int
+		designator byte
+	}{{p.Years, 'Y'}, {p.Months, 'M'}, {p.Weeks, 'W'}, {p.Days, 'D'}} {
+		if c.n != 0 {
+			b.WriteString(strconv.Itoa(c.n))
+			b.WriteByte(c.designator)
+		}
+	}
+
+	if p.Time != 0 {
+		b.WriteByte('T')
+		d := p.Time
+		sign := ""
+		if d < 0 {
+			sign = "-"
+			d = -d
+		}
+		if h := d / time.Hour; h != 0 {
+			fmt.Fprintf(&b, "%s%dH", sign, h)
+		}
+		if m := d % time.Hour / time.Minute; m != 0 {
+			fmt.Fprintf(&b, "%s%dM", sign, m)
+		}
+		if s := d % time.Minute; s != 0 {
+			secs := strconv.FormatInt(int64(s/time.Second), 10)
+			if ns := s % time.Second; ns != 0 {
+				secs += strings.TrimRight(fmt.Sprintf(".%09d", ns), "0")
+			}
+			fmt.Fprintf(&b, "%s%sS", sign, secs)
+		}
+	}
+	return b.String()
+}
+
+// MarshalText implements encoding.TextMarshaler using the ISO 8601 form.
+func (p Period) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePeriod.
+func (p *Period) UnmarshalText(data []byte) error {
+	parsed, err := ParsePeriod(string(data))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
@@ -0,0 +1,121 @@
+package gotime_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		in   string
+		want gotime.Period
+		str  string
+	}{
+		{"P1Y2M10DT2H30M", gotime.Period{Years: 1, Months: 2, Days: 10, Time: 2*time.Hour + 30*time.Minute}, "P1Y2M10DT2H30M"},
+		{"PT36H", gotime.Period{Time: 36 * time.Hour}, "PT36H"},
+		{"P3W", gotime.Period{Weeks: 3}, "P3W"},
+		{"P1D", gotime.Period{Days: 1}, "P1D"},
+		{"PT0S", gotime.Period{}, "PT0S"},
+		{"P0D", gotime.Period{}, "PT0S"},
+		{"PT1.5H", gotime.Period{Time: 90 * time.Minute}, "PT1H30M"},
+		{"PT0,25S", gotime.Period{Time: 250 * time.Millisecond}, "PT0.25S"},
+		{"PT1M0.000000001S", gotime.Period{Time: time.Minute + 1}, "PT1M0.000000001S"},
+		{"-P1DT12H", gotime.Period{Days: -1, Time: -12 * time.Hour}, "-P1DT12H"},
+		{"P1M-1D", gotime.Period{Months: 1, Days: -1}, "P1M-1D"},
+		{"+P2Y", gotime.Period{Years: 2}, "P2Y"},
+		{"p1yt1m", gotime.Period{Years: 1, Time: time.Minute}, "P1YT1M"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := gotime.ParsePeriod(tc.in)
+			utils.AssertEqual(t, nil, err)
+			utils.AssertEqual(t, tc.want, got)
+			utils.AssertEqual(t, tc.str, got.String())
+		})
+	}
+}
+
+func TestParsePeriodInvalid(t *testing.T) {
+	for _, in := range []string{"", "P", "PT", "1D", "P1", "P1H", "PT1D", "P1D1Y", "P1Y1Y", "PT1S1M", "P1.5D", "PTH", "P1DT", "P1DTT1H", "PT1.H", "PT-S"} {
+		t.Run(in, func(t *testing.T) {
+			_, err := gotime.ParsePeriod(in)
+			utils.AssertEqual(t, true, errors.Is(err, gotime.ErrInvalidPeriod))
+		})
+	}
+}
+
+func TestPeriodAddTo(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	tests := []struct {
+		name   string
+		period string
+		start  time.Time
+		want   time.Time
+	}{
+		{"Month", "P1M", time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC), time.Date(2025, 2, 15, 9, 0, 0, 0, time.UTC)},
+		{"Month overflow", "P1M", time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{"Mixed", "P1Y2M10DT2H30M", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 11, 2, 30, 0, 0, time.UTC)},
+		{"Weeks", "P3W", time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 7, 22, 0, 0, 0, 0, time.UTC)},
+		{"Negative", "-P1D", time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)},
+		// A calendar day across spring forward keeps the wall clock, 24 hours do not.
+		{"Day across DST", "P1D", time.Date(2025, 3, 8, 12, 0, 0, 0, ny), time.Date(2025, 3, 9, 12, 0, 0, 0, ny)},
+		{"Hours across DST", "PT24H", time.Date(2025, 3, 8, 12, 0, 0, 0, ny), time.Date(2025, 3, 9, 13, 0, 0, 0, ny)},
+		{"Into DST gap", "P1D", time.Date(2025, 3, 8, 2, 30, 0, 0, ny), time.Date(2025, 3, 9, 3, 30, 0, 0, ny)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := gotime.ParsePeriod(tc.period)
+			utils.AssertEqual(t, nil, err)
+			got := p.AddTo(tc.start)
+			if !got.Equal(tc.want) {
+				t.Errorf("AddTo() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPeriodDurationAndNormalize(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	month := gotime.Period{Months: 1}
+	utils.AssertEqual(t, 31*24*time.Hour, month.Duration(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+	utils.AssertEqual(t, 28*24*time.Hour, month.Duration(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)))
+	utils.AssertEqual(t, 23*time.Hour, gotime.Period{Days: 1}.Duration(time.Date(2025, 3, 9, 0, 0, 0, 0, ny)))
+
+	utils.AssertEqual(t, gotime.Period{Years: 1, Months: 2, Days: 21, Time: 36 * time.Hour}, gotime.Period{Months: 14, Weeks: 3, Time: 36 * time.Hour}.Normalize())
+	utils.AssertEqual(t, gotime.Period{Years: -1, Months: -2}, gotime.Period{Months: -14}.Normalize())
+	utils.AssertEqual(t, gotime.Period{Years: -1, Time: time.Second}, gotime.Period{Years: 1, Time: -time.Second}.Negate())
+	utils.AssertEqual(t, true, gotime.Period{}.IsZero())
+}
+
+func TestPeriodText(t *testing.T) {
+	type task struct {
+		Every gotime.Period `json:"every"`
+	}
+
+	data, err := json.Marshal(task{gotime.Period{Weeks: 2}})
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, `{"every":"P2W"}`, string(data))
+
+	var tk task
+	utils.AssertEqual(t, nil, json.Unmarshal([]byte(`{"every":"PT15M"}`), &tk))
+	utils.AssertEqual(t, gotime.Period{Time: 15 * time.Minute}, tk.Every)
+	utils.AssertEqual(t, true, json.Unmarshal([]byte(`{"every":"15m"}`), &tk) != nil)
+}
+
+func ExamplePeriod() {
+	p, _ := gotime.ParsePeriod("P1Y2M10DT2H30M")
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	fmt.Println(p.AddTo(start))
+	fmt.Println(p.Normalize(), gotime.Period{Months: 18}.Normalize())
+	// Output:
+	// 2025-03-11 02:30:00 +0000 UTC
+	// P1Y2M10DT2H30M P1Y6M
+}
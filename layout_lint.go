@@ -0,0 +1,167 @@
+package gotime
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/maniartech/gotime/v2/internal/nites"
+)
+
+// ErrInvalidLayout is returned by CompileLayoutStrict for a layout with issues.
+var ErrInvalidLayout = errors.New("invalid layout")
+
+// LayoutIssueKind classifies a problem found by ValidateLayout.
+type LayoutIssueKind int
+
+const (
+	// LayoutUnknownToken is a run of letters that isn't a NITES token and is
+	// written as is, such as "yyy" or "YYYY".
+	LayoutUnknownToken LayoutIssueKind = LayoutIssueKind(nites.IssueUnknownToken)
+	// LayoutMonthAsMinute is a month token where a minute is expected, such as
+	// the "mm" in "hh:mm".
+	LayoutMonthAsMinute LayoutIssueKind = LayoutIssueKind(nites.IssueMonthAsMinute)
+	// LayoutMinuteAsMonth is a minute token where a month is expected, such as
+	// the "ii" in "yyyy-ii-dd".
+	LayoutMinuteAsMonth LayoutIssueKind = LayoutIssueKind(nites.IssueMinuteAsMonth)
+	// LayoutMissingMeridiem is a 12-hour clock without an am/pm token.
+	LayoutMissingMeridiem LayoutIssueKind = LayoutIssueKind(nites.IssueMissingMeridiem)
+	// LayoutDuplicateField is a field given more than once, such as a year in
+	// both "yyyy" and "yy".
+	LayoutDuplicateField LayoutIssueKind = LayoutIssueKind(nites.IssueDuplicateField)
+	// LayoutMergedToken is a multi-letter token that older versions read as
+	// separate tokens or text, right next to other tokens or letters, such as
+	// the "ms" in "hms".
+	LayoutMergedToken LayoutIssueKind = LayoutIssueKind(nites.IssueMergedToken)
+)
+
+// String returns a short name for the kind, such as "unknown token".
+func (k LayoutIssueKind) String() string {
+	return nites.IssueKind(k).String()
+}
+
+// LayoutIssue is a likely mistake in a NITES layout. Pos is the byte offset of
+// Text in the layout.
+type LayoutIssue struct {
+	Pos     int
+	Text    string
+	Kind    LayoutIssueKind
+	Message string
+}
+
+// String returns the issue with its position, such as
+// `4: "mm" is the month; use "ii" for minutes`.
+func (i LayoutIssue) String() string {
+	return fmt.Sprintf("%d: %s", i.Pos, i.Message)
+}
+
+// ValidateLayout reports likely mistakes in a NITES layout, which Format and
+// Parse otherwise accept silently:
+//
+//   - letters that aren't tokens and are written as is, such as "yyy" or "YYYY";
+//     a single upper case letter such as the "T" in "yyyy-mm-ddThhhh" is fine
+//   - the month "mm" used as minutes, as in "hh:mm", or the minute "ii" used as
+//     the month, as in "yyyy-ii-dd"
+//   - a 12-hour "h" or "hh" without an am/pm token
+//   - a field given twice, such as "yyyy" and "yy"
+//   - one of the tokens ms, us, ns, dn, ww, wi, q, qq, fyy, fyyyy, unix,
+//     unixms, era and zzzz right next to other tokens or letters, such as "hms", which older versions read as the
+//     hour, the month and the second
+//
+// The issues are sorted by position. Built-in Go layouts such as time.RFC3339
+// have no issues.
+//
+// Example:
+//
+//	gotime.ValidateLayout("yyyy-mm-dd hhhh:mm")
+//	// [16: "mm" is the month; use "ii" for minutes]
+func ValidateLayout(layout string) []LayoutIssue {
+	found := nites.Validate(layout)
+	if len(found) == 0 {
+		return nil
+	}
+
+	issues := make([]LayoutIssue, len(found))
+	for i, is := range found {
+		issues[i] = LayoutIssue{Pos: is.Pos, Text: is.Text, Kind: LayoutIssueKind(is.Kind), Message: is.Message}
+	}
+	return issues
+}
+
+// CompiledLayout is a NITES layout that has been checked by ValidateLayout. It
+// formats and parses like the layout itself.
+type CompiledLayout struct {
+	layout string
+	issues []LayoutIssue
+}
+
+// CompileLayout checks a layout and returns it with its issues. It never fails;
+// use CompileLayoutStrict to reject layouts with issues.
+//
+// Example:
+//
+//	layout := gotime.CompileLayout("dd/mm/yyyy")
+//	layout.Format(time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC))
+//	// "04/07/2025"
+func CompileLayout(layout string) CompiledLayout {
+	return CompiledLayout{layout: layout, issues: ValidateLayout(layout)}
+}
+
+// CompileLayoutStrict checks a layout and fails with ErrInvalidLayout if
+// ValidateLayout reports any issue.
+//
+// Example:
+//
+//	_, err := gotime.CompileLayoutStrict("hh:mm")
+//	// err: invalid layout: "hh:mm": 0: "hh" is a 12-hour clock ...; 3: "mm" is the month; ...
+func CompileLayoutStrict(layout string) (CompiledLayout, error) {
+	compiled := CompileLayout(layout)
+	if len(compiled.issues) > 0 {
+		msgs := make([]string, len(compiled.issues))
+		for i, is := range compiled.issues {
+			msgs[i] = is.String()
+		}
+		return CompiledLayout{}, fmt.Errorf("%w: %q: %s", ErrInvalidLayout, layout, strings.Join(msgs, "; "))
+	}
+	return compiled, nil
+}
+
+// MustCompileLayout is like CompileLayoutStrict but panics if the layout has
+// issues. It is meant for layouts in package level variables.
+//
+// Example:
+//
+//	var invoiceDate = gotime.MustCompileLayout("dd mmm yyyy")
+func MustCompileLayout(layout string) CompiledLayout {
+	compiled, err := CompileLayoutStrict(layout)
+	if err != nil {
+		panic(err)
+	}
+	return compiled
+}
+
+// String returns the layout.
+func (l CompiledLayout) String() string {
+	return l.layout
+}
+
+// Issues returns the issues found when the layout was compiled.
+func (l CompiledLayout) Issues() []LayoutIssue {
+	return l.issues
+}
+
+// Format formats t with the layout, like Format.
+func (l CompiledLayout) Format(t time.Time) string {
+	return Format(t, l.layout)
+}
+
+// Parse parses value with the layout, like Parse.
+func (l CompiledLayout) Parse(value string) (time.Time, error) {
+	return Parse(l.layout, value)
+}
+
+// ParseInLocation parses value with the layout in loc, like ParseInLocation.
+func (l CompiledLayout) ParseInLocation(value string, loc *time.Location) (time.Time, error) {
+	return ParseInLocation(l.layout, value, loc)
+}
@@ -0,0 +1,79 @@
+package gotime_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestValidateLayout(t *testing.T) {
+	utils.AssertEqual(t, 0, len(gotime.ValidateLayout("yyyy-mm-ddThhhh:ii:ss ooo")))
+	utils.AssertEqual(t, 0, len(gotime.ValidateLayout(time.RFC1123)))
+
+	issues := gotime.ValidateLayout("yyy-mm-dd hh:mm")
+	utils.AssertEqual(t, 3, len(issues))
+	utils.AssertEqual(t, gotime.LayoutIssue{
+		Pos: 0, Text: "yyy", Kind: gotime.LayoutUnknownToken,
+		Message: `unknown token "yyy"; quote literal text, such as 'at'`,
+	}, issues[0])
+	utils.AssertEqual(t, gotime.LayoutMissingMeridiem, issues[1].Kind)
+	utils.AssertEqual(t, gotime.LayoutMonthAsMinute, issues[2].Kind)
+	utils.AssertEqual(t, `13: "mm" is the month; use "ii" for minutes`, issues[2].String())
+	utils.AssertEqual(t, "month as minute", issues[2].Kind.String())
+
+	issues = gotime.ValidateLayout("hhhhiims")
+	utils.AssertEqual(t, 1, len(issues))
+	utils.AssertEqual(t, gotime.LayoutMergedToken, issues[0].Kind)
+	utils.AssertEqual(t, 6, issues[0].Pos)
+	utils.AssertEqual(t, "merged token", issues[0].Kind.String())
+}
+
+func TestCompileLayout(t *testing.T) {
+	date := time.Date(2025, 7, 4, 9, 5, 0, 0, time.UTC)
+
+	layout := gotime.CompileLayout("hhhh:mm")
+	utils.AssertEqual(t, "hhhh:mm", layout.String())
+	utils.AssertEqual(t, 1, len(layout.Issues()))
+	utils.AssertEqual(t, "09:07", layout.Format(date))
+
+	layout, err := gotime.CompileLayoutStrict("dd/mm/yyyy hhhh:ii")
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, 0, len(layout.Issues()))
+	utils.AssertEqual(t, "04/07/2025 09:05", layout.Format(date))
+
+	parsed, err := layout.Parse("04/07/2025 09:05")
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, date, parsed)
+
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	parsed, err = layout.ParseInLocation("04/07/2025 09:05", ist)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, time.Date(2025, 7, 4, 9, 5, 0, 0, ist), parsed)
+}
+
+func TestCompileLayoutStrict(t *testing.T) {
+	_, err := gotime.CompileLayoutStrict("yyyy yy")
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrInvalidLayout))
+	utils.AssertEqual(t, `invalid layout: "yyyy yy": 5: "yy" repeats the year given by "yyyy"`, err.Error())
+
+	defer func() {
+		utils.AssertEqual(t, true, recover() != nil)
+	}()
+	gotime.MustCompileLayout("YYYY-MM-DD")
+}
+
+func ExampleValidateLayout() {
+	for _, issue := range gotime.ValidateLayout("yyyy-mm-dd hhhh:mm") {
+		fmt.Println(issue)
+	}
+
+	_, err := gotime.CompileLayoutStrict("yyy-mm-dd")
+	fmt.Println(err)
+	// Output:
+	// 16: "mm" is the month; use "ii" for minutes
+	// invalid layout: "yyy-mm-dd": 0: unknown token "yyy"; quote literal text, such as 'at'
+}
@@ -0,0 +1,82 @@
+package gotime
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/maniartech/gotime/v2/internal/nites"
+)
+
+// ErrUntranslatableLayout is returned when a layout uses tokens or text that
+// the target dialect can't express.
+var ErrUntranslatableLayout = errors.New("untranslatable layout")
+
+// LayoutDialect identifies a date layout syntax for TranslateLayout.
+type LayoutDialect int
+
+const (
+	// LayoutNITES is the NITES syntax used by this package, such as
+	// "yyyy-mm-dd hhhh:ii".
+	LayoutNITES LayoutDialect = iota
+	// LayoutGo is Go's reference time syntax, such as "2006-01-02 15:04".
+	LayoutGo
+	// LayoutStrftime is the C and Python strftime syntax, such as
+	// "%Y-%m-%d %H:%M". The glibc extensions %-d, %e, %P, %s and %:z are
+	// supported.
+	LayoutStrftime
+	// LayoutJava is the Java DateTimeFormatter and ICU syntax, such as
+	// "yyyy-MM-dd HH:mm".
+	LayoutJava
+	// LayoutMoment is the Moment.js syntax, such as "YYYY-MM-DD HH:mm".
+	LayoutMoment
+)
+
+// String returns the name of the dialect, such as "strftime".
+func (d LayoutDialect) String() string {
+	return nites.Dialect(d).String()
+}
+
+// TranslateLayout rewrites a date layout from one dialect to another, such as a
+// Python strftime format into NITES or NITES into a Moment.js format.
+//
+// Tokens that have no equivalent in the target dialect, such as the NITES
+// ordinal "dt" in strftime or Java's week of year "w" in any other dialect, are
+// not dropped: an error wrapping ErrUntranslatableLayout lists them as written
+// in the source layout. Literal text is quoted or escaped as the target dialect
+// requires; Go layouts have no escapes, so text that Go would read as a layout
+// element can't be translated to Go.
+//
+// Example:
+//
+//	gotime.TranslateLayout("%d/%m/%Y %H:%M", gotime.LayoutStrftime, gotime.LayoutNITES)
+//	// "dd/mm/yyyy hhhh:ii", nil
+//
+//	gotime.TranslateLayout("yyyy-mm-ddThhhh:ii", gotime.LayoutNITES, gotime.LayoutMoment)
+//	// "YYYY-MM-DD[T]HH:mm", nil
+//
+//	gotime.TranslateLayout("dt mmmm", gotime.LayoutNITES, gotime.LayoutStrftime)
+//	// "", untranslatable layout: "dt mmmm": no strftime equivalent for "dt"
+func TranslateLayout(layout string, from, to LayoutDialect) (string, error) {
+	translated, missing := nites.Translate(layout, nites.Dialect(from), nites.Dialect(to))
+	if len(missing) > 0 {
+		quoted := make([]string, len(missing))
+		for i, m := range missing {
+			quoted[i] = fmt.Sprintf("%q", m)
+		}
+		return "", fmt.Errorf("%w: %q: no %s equivalent for %s", ErrUntranslatableLayout, layout, to, strings.Join(quoted, ", "))
+	}
+	return translated, nil
+}
+
+// GoLayoutToNITES converts a Go reference time layout, such as time.RFC1123 or
+// "2006-01-02 15:04", into the equivalent NITES layout. It fails with
+// ErrUntranslatableLayout for elements NITES can't express, such as "Z07:00".
+//
+// Example:
+//
+//	gotime.GoLayoutToNITES("Mon, 02 Jan 2006 15:04:05 MST")
+//	// "www, dd mmm yyyy hhhh:ii:ss zz", nil
+func GoLayoutToNITES(layout string) (string, error) {
+	return TranslateLayout(layout, LayoutGo, LayoutNITES)
+}
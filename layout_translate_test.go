@@ -0,0 +1,79 @@
+package gotime_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestTranslateLayout(t *testing.T) {
+	tests := []struct {
+		layout   string
+		from, to gotime.LayoutDialect
+		want     string
+	}{
+		{"%d/%m/%Y %H:%M", gotime.LayoutStrftime, gotime.LayoutNITES, "dd/mm/yyyy hhhh:ii"},
+		{"dd/mm/yyyy hhhh:ii", gotime.LayoutNITES, gotime.LayoutStrftime, "%d/%m/%Y %H:%M"},
+		{"yyyy-MM-dd'T'HH:mm:ss.SSSxxx", gotime.LayoutJava, gotime.LayoutNITES, "yyyy-mm-ddThhhh:ii:ss.000ooo"},
+		{"yyyy-mm-ddThhhh:ii", gotime.LayoutNITES, gotime.LayoutMoment, "YYYY-MM-DD[T]HH:mm"},
+		{"MMM Do, YYYY", gotime.LayoutMoment, gotime.LayoutNITES, "mmm dt, yyyy"},
+		{time.RFC3339, gotime.LayoutGo, gotime.LayoutJava, "yyyy-MM-dd'T'HH:mm:ssXXX"},
+	}
+	for _, tc := range tests {
+		got, err := gotime.TranslateLayout(tc.layout, tc.from, tc.to)
+		utils.AssertEqual(t, nil, err)
+		utils.AssertEqual(t, tc.want, got)
+	}
+
+	// The NITES translation of a strftime format formats the same way.
+	layout, _ := gotime.TranslateLayout("%A, %-d %B %Y %I:%M %p", gotime.LayoutStrftime, gotime.LayoutNITES)
+	date := time.Date(2025, 7, 4, 21, 5, 0, 0, time.UTC)
+	utils.AssertEqual(t, "Friday, 4 July 2025 09:05 PM", gotime.Format(date, layout))
+}
+
+func TestTranslateLayoutUntranslatable(t *testing.T) {
+	got, err := gotime.TranslateLayout("dt mmmm, unixms", gotime.LayoutNITES, gotime.LayoutStrftime)
+	utils.AssertEqual(t, "", got)
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrUntranslatableLayout))
+	utils.AssertEqual(t, true, strings.HasSuffix(err.Error(), `no strftime equivalent for "dt", "unixms"`))
+
+	_, err = gotime.TranslateLayout("Qo YYYY", gotime.LayoutMoment, gotime.LayoutJava)
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrUntranslatableLayout))
+}
+
+func TestGoLayoutToNITES(t *testing.T) {
+	tests := []struct {
+		layout, want string
+	}{
+		{time.RFC1123, "www, dd mmm yyyy hhhh:ii:ss zz"},
+		{time.RFC1123Z, "www, dd mmm yyyy hhhh:ii:ss oo"},
+		{time.Kitchen, "h:iiaa"},
+		{time.StampMicro, "mmm db hhhh:ii:ss.000000"},
+		{"2006-01-02 15:04:05.999 -07:00", "yyyy-mm-dd hhhh:ii:ss.999 ooo"},
+		{"Monday at 3pm", "wwww 'at' ha"},
+	}
+	for _, tc := range tests {
+		got, err := gotime.GoLayoutToNITES(tc.layout)
+		utils.AssertEqual(t, nil, err)
+		utils.AssertEqual(t, tc.want, got)
+	}
+
+	_, err := gotime.GoLayoutToNITES(time.RFC3339)
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrUntranslatableLayout))
+}
+
+func ExampleTranslateLayout() {
+	layout, _ := gotime.TranslateLayout("%d/%m/%Y %H:%M", gotime.LayoutStrftime, gotime.LayoutNITES)
+	fmt.Println(layout)
+
+	_, err := gotime.TranslateLayout("dt mmmm", gotime.LayoutNITES, gotime.LayoutStrftime)
+	fmt.Println(err)
+	// Output:
+	// dd/mm/yyyy hhhh:ii
+	// untranslatable layout: "dt mmmm": no strftime equivalent for "dt"
+}
@@ -119,3 +119,46 @@ func ReplaceDate(t time.Time, year, month, day int) time.Time {
 func ReplaceTime(t time.Time, hour, minute, second int) time.Time {
 	return time.Date(t.Year(), t.Month(), t.Day(), hour, minute, second, t.Nanosecond(), t.Location())
 }
+
+// NewDateWithPolicy is like NewDate but resolves a midnight that is skipped or
+// repeated by a DST transition with the given policy instead of relying on
+// time.Date normalization. With DSTReject it returns ErrNonexistentTime or
+// ErrAmbiguousTime.
+//
+// Example:
+//   loc, _ := gotime.LoadLocation("America/Santiago")
+//   date, err := gotime.NewDateWithPolicy(2024, 9, 8, loc, gotime.DSTShiftForward)
+//   // date == 2024-09-08 01:00:00 -03 (midnight does not exist that day)
+func NewDateWithPolicy(year, month, day int, loc *time.Location, policy DSTPolicy) (time.Time, error) {
+	return DateInLocation(loc, year, month, day, 0, 0, 0, 0, policy)
+}
+
+// NewTimeWithPolicy is like NewTime but resolves the wall clock time with the
+// given DST policy.
+func NewTimeWithPolicy(hour, minute, second int, loc *time.Location, policy DSTPolicy) (time.Time, error) {
+	return DateInLocation(loc, 0, 0, 0, hour, minute, second, 0, policy)
+}
+
+// ReplaceDateWithPolicy is like ReplaceDate but resolves the resulting wall clock
+// time with the given DST policy.
+//
+// Example:
+//   loc, _ := gotime.LoadLocation("America/New_York")
+//   t := time.Date(2025, 3, 1, 2, 30, 0, 0, loc)
+//   _, err := gotime.ReplaceDateWithPolicy(t, 2025, 3, 9, gotime.DSTReject)
+//   // errors.Is(err, gotime.ErrNonexistentTime) == true
+func ReplaceDateWithPolicy(t time.Time, year, month, day int, policy DSTPolicy) (time.Time, error) {
+	return DateInLocation(t.Location(), year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), policy)
+}
+
+// ReplaceTimeWithPolicy is like ReplaceTime but resolves the resulting wall clock
+// time with the given DST policy.
+//
+// Example:
+//   loc, _ := gotime.LoadLocation("America/New_York")
+//   t := time.Date(2025, 11, 2, 12, 0, 0, 0, loc)
+//   later, _ := gotime.ReplaceTimeWithPolicy(t, 1, 30, 0, gotime.DSTLatest)
+//   // later == 2025-11-02 01:30:00 -0500 EST
+func ReplaceTimeWithPolicy(t time.Time, hour, minute, second int, policy DSTPolicy) (time.Time, error) {
+	return DateInLocation(t.Location(), t.Year(), int(t.Month()), t.Day(), hour, minute, second, t.Nanosecond(), policy)
+}
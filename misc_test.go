@@ -1,10 +1,12 @@
 package gotime_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
 )
 
 // TestIsLeapYear tests IsLeapYear function.
@@ -169,3 +171,68 @@ func TestReplaceTime(t *testing.T) {
 		t.Errorf("Expected %v, got %v", expected, newTime)
 	}
 }
+
+func TestConstructorsWithPolicy(t *testing.T) {
+	newYork := mustLoad(t, "America/New_York")
+	santiago := mustLoad(t, "America/Santiago")
+	havana := mustLoad(t, "America/Havana")
+
+	// Midnight does not exist in Santiago on 2024-09-08 or in Havana on 2025-03-09
+	date, err := gotime.NewDateWithPolicy(2024, 9, 8, santiago, gotime.DSTShiftForward)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, time.Date(2024, 9, 8, 4, 0, 0, 0, time.UTC), date)
+	utils.AssertEqual(t, 1, date.Hour())
+
+	date, err = gotime.NewDateWithPolicy(2025, 3, 9, havana, gotime.DSTEarliest)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, time.Date(2025, 3, 8, 23, 0, 0, 0, havana), date)
+
+	_, err = gotime.NewDateWithPolicy(2025, 3, 9, havana, gotime.DSTReject)
+	if !errors.Is(err, gotime.ErrNonexistentTime) {
+		t.Errorf("Expected ErrNonexistentTime, got %v", err)
+	}
+
+	// Ordinary dates behave like NewDate
+	date, err = gotime.NewDateWithPolicy(2025, 6, 1, newYork, gotime.DSTReject)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, gotime.NewDate(2025, 6, 1, newYork), date)
+
+	tm, err := gotime.NewTimeWithPolicy(12, 30, 0, newYork, gotime.DSTReject)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, gotime.NewTime(12, 30, 0, newYork), tm)
+}
+
+func TestReplaceWithPolicy(t *testing.T) {
+	newYork := mustLoad(t, "America/New_York")
+	london := mustLoad(t, "Europe/London")
+
+	// 02:30 does not exist in New York on 2025-03-09
+	src := time.Date(2025, 3, 1, 2, 30, 0, 0, newYork)
+	_, err := gotime.ReplaceDateWithPolicy(src, 2025, 3, 9, gotime.DSTReject)
+	if !errors.Is(err, gotime.ErrNonexistentTime) {
+		t.Errorf("Expected ErrNonexistentTime, got %v", err)
+	}
+	shifted, err := gotime.ReplaceDateWithPolicy(src, 2025, 3, 9, gotime.DSTShiftForward)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC), shifted)
+
+	// 01:30 happens twice in London on 2025-10-26
+	day := time.Date(2025, 10, 26, 12, 0, 0, 0, london)
+	earliest, err := gotime.ReplaceTimeWithPolicy(day, 1, 30, 0, gotime.DSTEarliest)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, time.Date(2025, 10, 26, 0, 30, 0, 0, time.UTC), earliest)
+
+	latest, err := gotime.ReplaceTimeWithPolicy(day, 1, 30, 0, gotime.DSTLatest)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, time.Date(2025, 10, 26, 1, 30, 0, 0, time.UTC), latest)
+
+	_, err = gotime.ReplaceTimeWithPolicy(day, 1, 30, 0, gotime.DSTReject)
+	if !errors.Is(err, gotime.ErrAmbiguousTime) {
+		t.Errorf("Expected ErrAmbiguousTime, got %v", err)
+	}
+
+	// Ordinary times behave like ReplaceTime
+	same, err := gotime.ReplaceTimeWithPolicy(day, 15, 45, 0, gotime.DSTReject)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, gotime.ReplaceTime(day, 15, 45, 0), same)
+}
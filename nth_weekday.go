@@ -0,0 +1,203 @@
+package gotime
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrWeekdayNotFound is returned when a requested weekday occurrence does not
+// exist, such as the fifth Monday of a month that has only four.
+var ErrWeekdayNotFound = errors.New("weekday occurrence not found")
+
+// NthWeekdayInRange returns the nth occurrence of the given weekday between
+// start and end (inclusive, compared by date), at the start of the day. A
+// negative n counts from the end, so -1 is the last occurrence. If end is
+// before start, the dates are swapped.
+//
+// It returns ErrWeekdayNotFound when n is zero or the range has fewer than |n|
+// such weekdays.
+//
+// Example:
+//
+//	start := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+//	end := time.Date(2025, 7, 20, 0, 0, 0, 0, time.UTC)
+//	t, err := gotime.NthWeekdayInRange(2, time.Friday, start, end)
+//	// t: 2025-07-11 00:00:00
+//	t, err = gotime.NthWeekdayInRange(-1, time.Friday, start, end)
+//	// t: 2025-07-18 00:00:00
+func NthWeekdayInRange(n int, day time.Weekday, start, end time.Time) (time.Time, error) {
+	if end.Before(start) {
+		start, end = end, start
+	}
+	start, end = SoD(start), SoD(end)
+
+	var t time.Time
+	switch {
+	case n > 0:
+		t = SoD(Weeks(n-1, NextOrSameWeekday(day, start)))
+	case n < 0:
+		t = SoD(Weeks(n+1, PrevOrSameWeekday(day, end)))
+	default:
+		return time.Time{}, fmt.Errorf("%w: n must not be zero", ErrWeekdayNotFound)
+	}
+	if t.Before(start) || t.After(end) {
+		return time.Time{}, fmt.Errorf("%w: occurrence %d of %s between %s and %s",
+			ErrWeekdayNotFound, n, day, start.Format("2006-01-02"), end.Format("2006-01-02"))
+	}
+	return t, nil
+}
+
+// NthWeekdayOfMonth returns the nth occurrence of the given weekday in the month
+// of the given date, at the start of the day. A negative n counts from the end
+// of the month. If no date is provided, it uses the current month.
+//
+// It returns ErrWeekdayNotFound when the month has no such occurrence, such as a
+// fifth Monday.
+//
+// Example:
+//
+//	t, err := gotime.NthWeekdayOfMonth(3, time.Wednesday, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC))
+//	// t: 2025-07-16 00:00:00
+func NthWeekdayOfMonth(n int, day time.Weekday, dt ...time.Time) (time.Time, error) {
+	return nthWeekdayOfPeriod(PeriodMonth, n, day, dt...)
+}
+
+// LastWeekdayOfMonth returns the last occurrence of the given weekday in the
+// month of the given date, at the start of the day. If no date is provided, it
+// uses the current month.
+//
+// Example:
+//
+//	t := gotime.LastWeekdayOfMonth(time.Friday, time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC))
+//	// t: 2025-08-29 00:00:00
+func LastWeekdayOfMonth(day time.Weekday, dt ...time.Time) time.Time {
+	t, _ := nthWeekdayOfPeriod(PeriodMonth, -1, day, dt...)
+	return t
+}
+
+// NthWeekdayOfQuarter returns the nth occurrence of the given weekday in the
+// quarter of the given date, at the start of the day. A negative n counts from
+// the end of the quarter. If no date is provided, it uses the current quarter.
+//
+// It returns ErrWeekdayNotFound when the quarter has no such occurrence.
+//
+// Example:
+//
+//	t, err := gotime.NthWeekdayOfQuarter(1, time.Monday, time.Date(2025, 8, 15, 0, 0, 0, 0, time.UTC))
+//	// t: 2025-07-07 00:00:00
+func NthWeekdayOfQuarter(n int, day time.Weekday, dt ...time.Time) (time.Time, error) {
+	return nthWeekdayOfPeriod(PeriodQuarter, n, day, dt...)
+}
+
+// LastWeekdayOfQuarter returns the last occurrence of the given weekday in the
+// quarter of the given date, at the start of the day. If no date is provided, it
+// uses the current quarter.
+//
+// Example:
+//
+//	t := gotime.LastWeekdayOfQuarter(time.Friday, time.Date(2025, 8, 15, 0, 0, 0, 0, time.UTC))
+//	// t: 2025-09-26 00:00:00
+func LastWeekdayOfQuarter(day time.Weekday, dt ...time.Time) time.Time {
+	t, _ := nthWeekdayOfPeriod(PeriodQuarter, -1, day, dt...)
+	return t
+}
+
+// NthWeekdayOfYear returns the nth occurrence of the given weekday in the year
+// of the given date, at the start of the day. A negative n counts from the end
+// of the year. If no date is provided, it uses the current year.
+//
+// It returns ErrWeekdayNotFound when the year has no such occurrence, such as a
+// 54th Monday.
+//
+// Example:
+//
+//	t, err := gotime.NthWeekdayOfYear(10, time.Tuesday, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+//	// t: 2025-03-11 00:00:00
+func NthWeekdayOfYear(n int, day time.Weekday, dt ...time.Time) (time.Time, error) {
+	return nthWeekdayOfPeriod(PeriodYear, n, day, dt...)
+}
+
+// LastWeekdayOfYear returns the last occurrence of the given weekday in the year
+// of the given date, at the start of the day. If no date is provided, it uses
+// the current year.
+//
+// Example:
+//
+//	t := gotime.LastWeekdayOfYear(time.Sunday, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+//	// t: 2025-12-28 00:00:00
+func LastWeekdayOfYear(day time.Weekday, dt ...time.Time) time.Time {
+	t, _ := nthWeekdayOfPeriod(PeriodYear, -1, day, dt...)
+	return t
+}
+
+// nthWeekdayOfPeriod returns the nth given weekday in the period containing the
+// given date. The date is read once, so both bounds come from the same period.
+func nthWeekdayOfPeriod(unit PeriodUnit, n int, day time.Weekday, dt ...time.Time) (time.Time, error) {
+	t := weekdayBase(dt...)
+	return NthWeekdayInRange(n, day, PeriodStart(unit, t), PeriodEnd(unit, t))
+}
+
+// NextWeekday returns the first time strictly after the given date that falls on
+// the given weekday, keeping the clock time. If no date is provided, it uses the
+// current time.
+//
+// Example:
+//
+//	wed := time.Date(2025, 7, 9, 10, 0, 0, 0, time.UTC)
+//	gotime.NextWeekday(time.Friday, wed)    // 2025-07-11 10:00:00
+//	gotime.NextWeekday(time.Wednesday, wed) // 2025-07-16 10:00:00
+func NextWeekday(day time.Weekday, dt ...time.Time) time.Time {
+	t := weekdayBase(dt...)
+	return Days((int(day)-int(t.Weekday())+6)%7+1, t)
+}
+
+// NextOrSameWeekday returns the given date if it falls on the given weekday, or
+// else the next date that does, keeping the clock time. If no date is provided,
+// it uses the current time.
+//
+// Example:
+//
+//	wed := time.Date(2025, 7, 9, 10, 0, 0, 0, time.UTC)
+//	gotime.NextOrSameWeekday(time.Wednesday, wed) // 2025-07-09 10:00:00
+//	gotime.NextOrSameWeekday(time.Tuesday, wed)   // 2025-07-15 10:00:00
+func NextOrSameWeekday(day time.Weekday, dt ...time.Time) time.Time {
+	t := weekdayBase(dt...)
+	return Days((int(day)-int(t.Weekday())+7)%7, t)
+}
+
+// PrevWeekday returns the last time strictly before the given date that falls on
+// the given weekday, keeping the clock time. If no date is provided, it uses the
+// current time.
+//
+// Example:
+//
+//	wed := time.Date(2025, 7, 9, 10, 0, 0, 0, time.UTC)
+//	gotime.PrevWeekday(time.Friday, wed)    // 2025-07-04 10:00:00
+//	gotime.PrevWeekday(time.Wednesday, wed) // 2025-07-02 10:00:00
+func PrevWeekday(day time.Weekday, dt ...time.Time) time.Time {
+	t := weekdayBase(dt...)
+	return Days(-((int(t.Weekday())-int(day)+6)%7 + 1), t)
+}
+
+// PrevOrSameWeekday returns the given date if it falls on the given weekday, or
+// else the previous date that does, keeping the clock time. If no date is
+// provided, it uses the current time.
+//
+// Example:
+//
+//	wed := time.Date(2025, 7, 9, 10, 0, 0, 0, time.UTC)
+//	gotime.PrevOrSameWeekday(time.Wednesday, wed) // 2025-07-09 10:00:00
+//	gotime.PrevOrSameWeekday(time.Thursday, wed)  // 2025-07-03 10:00:00
+func PrevOrSameWeekday(day time.Weekday, dt ...time.Time) time.Time {
+	t := weekdayBase(dt...)
+	return Days(-((int(t.Weekday()) - int(day) + 7) % 7), t)
+}
+
+// weekdayBase returns the first given date, or the current time.
+func weekdayBase(dt ...time.Time) time.Time {
+	if len(dt) > 0 {
+		return dt[0]
+	}
+	return Now()
+}
@@ -0,0 +1,156 @@
+package gotime_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	july := time.Date(2025, 7, 20, 15, 45, 0, 0, time.UTC)
+
+	tests := []struct {
+		n    int
+		day  time.Weekday
+		want time.Time
+	}{
+		{1, time.Tuesday, day(2025, 7, 1)},
+		{1, time.Monday, day(2025, 7, 7)},
+		{3, time.Wednesday, day(2025, 7, 16)},
+		{5, time.Thursday, day(2025, 7, 31)},
+		{-1, time.Thursday, day(2025, 7, 31)},
+		{-1, time.Friday, day(2025, 7, 25)},
+		{-2, time.Friday, day(2025, 7, 18)},
+		{-5, time.Tuesday, day(2025, 7, 1)},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%d %s", tc.n, tc.day), func(t *testing.T) {
+			got, err := gotime.NthWeekdayOfMonth(tc.n, tc.day, july)
+			utils.AssertEqual(t, nil, err)
+			utils.AssertEqual(t, tc.want, got)
+		})
+	}
+
+	for _, tc := range []struct {
+		n   int
+		day time.Weekday
+	}{{5, time.Monday}, {-5, time.Monday}, {6, time.Tuesday}, {0, time.Tuesday}} {
+		_, err := gotime.NthWeekdayOfMonth(tc.n, tc.day, july)
+		utils.AssertEqual(t, true, errors.Is(err, gotime.ErrWeekdayNotFound))
+	}
+
+	utils.AssertEqual(t, day(2025, 8, 29), gotime.LastWeekdayOfMonth(time.Friday, day(2025, 8, 1)))
+	utils.AssertEqual(t, day(2024, 2, 29), gotime.LastWeekdayOfMonth(time.Thursday, day(2024, 2, 10)))
+}
+
+func TestNthWeekdayOfQuarterAndYear(t *testing.T) {
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	august := day(2025, 8, 15)
+
+	got, err := gotime.NthWeekdayOfQuarter(1, time.Monday, august)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, day(2025, 7, 7), got)
+
+	got, err = gotime.NthWeekdayOfQuarter(13, time.Tuesday, august)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, day(2025, 9, 23), got)
+
+	_, err = gotime.NthWeekdayOfQuarter(15, time.Tuesday, august)
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrWeekdayNotFound))
+
+	utils.AssertEqual(t, day(2025, 9, 26), gotime.LastWeekdayOfQuarter(time.Friday, august))
+
+	got, err = gotime.NthWeekdayOfYear(10, time.Tuesday, august)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, day(2025, 3, 11), got)
+
+	got, err = gotime.NthWeekdayOfYear(53, time.Wednesday, august)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, day(2025, 12, 31), got)
+
+	_, err = gotime.NthWeekdayOfYear(53, time.Thursday, august)
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrWeekdayNotFound))
+
+	utils.AssertEqual(t, day(2025, 12, 28), gotime.LastWeekdayOfYear(time.Sunday, august))
+}
+
+func TestNthWeekdayInRange(t *testing.T) {
+	start := time.Date(2025, 7, 1, 18, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 7, 18, 6, 0, 0, 0, time.UTC)
+
+	got, err := gotime.NthWeekdayInRange(1, time.Tuesday, start, end)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), got)
+
+	// The end date is included even though its clock time is early.
+	got, err = gotime.NthWeekdayInRange(-1, time.Friday, start, end)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, time.Date(2025, 7, 18, 0, 0, 0, 0, time.UTC), got)
+
+	// Swapped bounds.
+	got, err = gotime.NthWeekdayInRange(2, time.Friday, end, start)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, time.Date(2025, 7, 11, 0, 0, 0, 0, time.UTC), got)
+
+	_, err = gotime.NthWeekdayInRange(4, time.Friday, start, end)
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrWeekdayNotFound))
+
+	// Midnight was skipped in Sao Paulo on 2018-11-04, so that day starts at
+	// 01:00; the following Sundays still start at midnight.
+	sp := mustLoad(t, "America/Sao_Paulo")
+	got, err = gotime.NthWeekdayInRange(2, time.Sunday, time.Date(2018, 11, 4, 12, 0, 0, 0, sp), time.Date(2018, 11, 30, 0, 0, 0, 0, sp))
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, time.Date(2018, 11, 11, 0, 0, 0, 0, sp), got)
+}
+
+func TestNextPrevWeekday(t *testing.T) {
+	// Wednesday.
+	wed := time.Date(2025, 7, 9, 10, 30, 0, 0, time.UTC)
+	at := func(d int) time.Time { return time.Date(2025, 7, d, 10, 30, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name string
+		fn   func(time.Weekday, ...time.Time) time.Time
+		day  time.Weekday
+		want time.Time
+	}{
+		{"Next later in week", gotime.NextWeekday, time.Friday, at(11)},
+		{"Next same day", gotime.NextWeekday, time.Wednesday, at(16)},
+		{"Next earlier in week", gotime.NextWeekday, time.Monday, at(14)},
+		{"NextOrSame same day", gotime.NextOrSameWeekday, time.Wednesday, at(9)},
+		{"NextOrSame other day", gotime.NextOrSameWeekday, time.Tuesday, at(15)},
+		{"Prev earlier in week", gotime.PrevWeekday, time.Monday, at(7)},
+		{"Prev same day", gotime.PrevWeekday, time.Wednesday, at(2)},
+		{"Prev later in week", gotime.PrevWeekday, time.Friday, at(4)},
+		{"PrevOrSame same day", gotime.PrevOrSameWeekday, time.Wednesday, at(9)},
+		{"PrevOrSame other day", gotime.PrevOrSameWeekday, time.Thursday, at(3)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			utils.AssertEqual(t, tc.want, tc.fn(tc.day, wed))
+		})
+	}
+
+	now := time.Now()
+	utils.AssertEqual(t, time.Friday, gotime.NextWeekday(time.Friday).Weekday())
+	utils.AssertEqual(t, true, gotime.NextWeekday(now.Weekday()).After(now))
+	utils.AssertEqual(t, true, gotime.PrevWeekday(now.Weekday()).Before(now))
+}
+
+func ExampleNthWeekdayOfMonth() {
+	july := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	t, _ := gotime.NthWeekdayOfMonth(3, time.Wednesday, july)
+	fmt.Println(t.Format("2006-01-02"))
+
+	_, err := gotime.NthWeekdayOfMonth(5, time.Monday, july)
+	fmt.Println(err)
+	// Output:
+	// 2025-07-16
+	// weekday occurrence not found: occurrence 5 of Monday between 2025-07-01 and 2025-07-31
+}
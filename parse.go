@@ -4,6 +4,37 @@ import (
 	"time"
 
 	"github.com/maniartech/gotime/v2/internal/nites"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+// ParseError describes a value that doesn't match a NITES layout. It carries
+// the layout as written, the token or literal text that failed, the byte offset
+// in the value (-1 if unknown) and, for common mistakes, a hint. Err is the
+// underlying error, such as the *time.ParseError returned by Go's time package.
+// Parse, ParseInLocation and ParseWithOptions return *ParseError values.
+//
+// Example:
+//
+//	_, err := gotime.Parse("dd/mm/yyyy", "5/7/2024")
+//	var pe *gotime.ParseError
+//	if errors.As(err, &pe) {
+//		// pe.Token: "dd", pe.Offset: 0
+//		// pe.Hint: "dd" needs two digits; use "d" to accept one
+//	}
+type ParseError = nites.ParseError
+
+var (
+	// ErrOrdinalsNotSupported is returned when parsing with an ordinal token,
+	// "dt" or "mt", which can only be formatted.
+	ErrOrdinalsNotSupported = nites.ErrOrdinalsNotSupported
+
+	// ErrFiscalNotSupported is returned when parsing with a fiscal year token,
+	// "fyyyy" or "fyy", which can only be formatted.
+	ErrFiscalNotSupported = nites.ErrFiscalNotSupported
+
+	// ErrEpochWithFields is returned when parsing with a unix time token and
+	// another field, since the unix time alone gives the instant.
+	ErrEpochWithFields = nites.ErrEpochWithFields
 )
 
 // Parse parses a date-time string according to the specified layout format.
@@ -35,3 +66,79 @@ func Parse(layout, value string) (time.Time, error) {
 func ParseInLocation(layout, value string, loc *time.Location) (time.Time, error) {
 	return nites.ParseInLocation(layout, value, loc)
 }
+
+// Leniency is a set of ways in which ParseWithOptions may let a value depart
+// from its layout. Leniencies are combined with |.
+type Leniency uint
+
+const (
+	// LenientDigits accepts fewer digits than a padded token writes, such as
+	// "5/7/2024" for "dd/mm/yyyy".
+	LenientDigits Leniency = Leniency(nites.LenientDigits)
+	// LenientCase accepts am/pm markers, eras, quarters, zone abbreviations
+	// and literal text in any case. Month and weekday names are always
+	// matched in any case.
+	LenientCase Leniency = Leniency(nites.LenientCase)
+	// LenientNames accepts a full month or weekday name where the layout has
+	// an abbreviation and the other way round, and any abbreviation of at
+	// least three letters, such as "Sept" or "Tues".
+	LenientNames Leniency = Leniency(nites.LenientNames)
+	// LenientSeparators accepts any run of spaces and the punctuation "-/.,:"
+	// where the layout has separators, and whitespace around the value.
+	LenientSeparators Leniency = Leniency(nites.LenientSeparators)
+	// LenientSurroundingText ignores text before and after the date, such as
+	// the "Due on " in "Due on 05/07/2024".
+	LenientSurroundingText Leniency = Leniency(nites.LenientSurroundingText)
+
+	// LenientAll allows every leniency.
+	LenientAll = LenientDigits | LenientCase | LenientNames | LenientSeparators | LenientSurroundingText
+)
+
+// String returns the names of the leniencies, such as "digits, names", or
+// "none".
+func (l Leniency) String() string {
+	return nites.Leniency(l).String()
+}
+
+// ParseOptions configures ParseWithOptions. The zero value parses strictly in
+// UTC, like Parse.
+type ParseOptions struct {
+	// Location is used for values without zone information. A nil Location
+	// means UTC.
+	Location *time.Location
+
+	// Lenient selects the ways in which the value may depart from the layout.
+	Lenient Leniency
+}
+
+// ParseWithOptions parses a date-time string like ParseInLocation, allowing
+// the value to depart from the layout in the ways selected by opts.Lenient.
+// It also returns the leniencies the value needed, so that they can be
+// recorded; a value that fits the layout needs none. A value that only fits
+// leniently is rewritten to fit the layout before it is parsed, so an error
+// for it, such as a day out of range, quotes the rewritten value.
+//
+// Example:
+//
+//	opts := gotime.ParseOptions{Lenient: gotime.LenientAll}
+//	t, applied, err := gotime.ParseWithOptions("dd mmm yyyy", "Paid on 5 Sept 2024.", opts)
+//	// t: 2024-09-05 00:00:00 +0000 UTC
+//	// applied: digits, names, surrounding text
+func ParseWithOptions(layout, value string, opts ParseOptions) (time.Time, Leniency, error) {
+	loc := opts.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	t, applied, err := nites.ParseLenient(layout, value, loc, nites.Leniency(opts.Lenient))
+	return t, Leniency(applied), err
+}
+
+// parseValue parses value with a NITES layout in loc. Unlike nites.ParseInLocation
+// it also accepts the built-in Go layouts such as time.RFC3339, which the value
+// types (Date, TimeOfDay and Formatted) allow as their layout.
+func parseValue(layout, value string, loc *time.Location) (time.Time, error) {
+	if _, ok := utils.BuiltInLayouts[layout]; ok {
+		return time.ParseInLocation(layout, value, loc)
+	}
+	return nites.ParseInLocation(layout, value, loc)
+}
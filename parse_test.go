@@ -1,6 +1,8 @@
 package gotime_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -87,6 +89,78 @@ func TestParseInLocation(t *testing.T) {
 
 }
 
+func TestParseError(t *testing.T) {
+	_, err := gotime.Parse("dd mmm yyyy", "04 Jly 2025")
+	var pe *gotime.ParseError
+	utils.AssertEqual(t, true, errors.As(err, &pe))
+	utils.AssertEqual(t, "dd mmm yyyy", pe.Layout)
+	utils.AssertEqual(t, "mmm", pe.Token)
+	utils.AssertEqual(t, 3, pe.Offset)
+	utils.AssertEqual(t, `parsing time "04 Jly 2025" as "dd mmm yyyy": cannot parse "Jly 2025" as "mmm"`, err.Error())
+
+	var goErr *time.ParseError
+	utils.AssertEqual(t, true, errors.As(err, &goErr))
+	utils.AssertEqual(t, "Jan", goErr.LayoutElem)
+
+	_, err = gotime.ParseInLocation("dt mmmm yyyy", "4th July 2025", time.UTC)
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrOrdinalsNotSupported))
+	_, err = gotime.Parse("'FY'fyy", "FY26")
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrFiscalNotSupported))
+	_, err = gotime.Parse("unix hhhh", "1720512000 15")
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrEpochWithFields))
+}
+
+func ExampleParseError() {
+	_, err := gotime.Parse("dd/mm/yyyy", "5/7/2024")
+
+	var pe *gotime.ParseError
+	if errors.As(err, &pe) {
+		fmt.Printf("%q at offset %d: %s\n", pe.Token, pe.Offset, pe.Hint)
+	}
+	// Output: "dd" at offset 0: "dd" needs two digits; use "d" to accept one
+}
+
+func TestParseWithOptions(t *testing.T) {
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+
+	// The zero options parse strictly in UTC.
+	_, applied, err := gotime.ParseWithOptions("dd/mm/yyyy", "5/7/2024", gotime.ParseOptions{})
+	utils.AssertEqual(t, `parsing time "5/7/2024" as "dd/mm/yyyy": cannot parse "5/7/2024" as "dd"; "dd" needs two digits; use "d" to accept one`, err.Error())
+	utils.AssertEqual(t, gotime.Leniency(0), applied)
+
+	parsed, applied, err := gotime.ParseWithOptions("dd/mm/yyyy", "05/07/2024", gotime.ParseOptions{Lenient: gotime.LenientAll})
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, time.Date(2024, 7, 5, 0, 0, 0, 0, time.UTC), parsed)
+	utils.AssertEqual(t, "none", applied.String())
+
+	opts := gotime.ParseOptions{Location: ist, Lenient: gotime.LenientDigits | gotime.LenientSeparators}
+	parsed, applied, err = gotime.ParseWithOptions("dd/mm/yyyy hhhh:ii", " 5-7-2024  9:5 ", opts)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, time.Date(2024, 7, 5, 9, 5, 0, 0, ist), parsed)
+	utils.AssertEqual(t, gotime.LenientDigits|gotime.LenientSeparators, applied)
+
+	// Leniencies that aren't allowed aren't applied.
+	_, _, err = gotime.ParseWithOptions("mmm d, yyyy", "Sept 5, 2024", opts)
+	utils.AssertEqual(t, true, err != nil)
+
+	parsed, applied, err = gotime.ParseWithOptions("www, mmm d, yyyy h:ii aa", "Shipped tues, SEPT 10, 2024 4:30 pm!", gotime.ParseOptions{Lenient: gotime.LenientAll})
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, time.Date(2024, 9, 10, 16, 30, 0, 0, time.UTC), parsed)
+	utils.AssertEqual(t, "case, names, surrounding text", applied.String())
+}
+
+func ExampleParseWithOptions() {
+	opts := gotime.ParseOptions{Lenient: gotime.LenientAll}
+	for _, value := range []string{"05 Sep 2024", "5 sept. 2024", "Paid on 5-Sep-2024."} {
+		t, applied, err := gotime.ParseWithOptions("dd mmm yyyy", value, opts)
+		fmt.Println(t.Format("2006-01-02"), applied, err)
+	}
+	// Output:
+	// 2024-09-05 none <nil>
+	// 2024-09-05 digits, names, separators <nil>
+	// 2024-09-05 digits, separators, surrounding text <nil>
+}
+
 func testParse(layout, value string) time.Time {
 	dt, err := gotime.Parse(layout, value)
 	if err != nil {
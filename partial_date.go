@@ -0,0 +1,265 @@
+package gotime
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/maniartech/gotime/v2/internal/nites"
+)
+
+// ErrInvalidPartialDate is returned by ParsePartial for a value that matches
+// none of the layouts.
+var ErrInvalidPartialDate = errors.New("invalid partial date")
+
+// PartialField is a set of the fields given by a partial date. Fields are
+// combined with |.
+type PartialField uint
+
+const (
+	// PartialYear is the year, as in "2019".
+	PartialYear PartialField = 1 << iota
+	// PartialQuarter is the quarter, as in "Q3 2019".
+	PartialQuarter
+	// PartialMonth is the month, as in "July 2019".
+	PartialMonth
+	// PartialWeek is the week number, as in "2019-W27".
+	PartialWeek
+	// PartialDay is the day of the month or of the year.
+	PartialDay
+	// PartialHour is the hour.
+	PartialHour
+	// PartialMinute is the minute.
+	PartialMinute
+	// PartialSecond is the second.
+	PartialSecond
+)
+
+var partialFieldNames = []string{"year", "quarter", "month", "week", "day", "hour", "minute", "second"}
+
+// String returns the names of the fields, such as "year, month", or "none".
+func (f PartialField) String() string {
+	var names []string
+	for i, name := range partialFieldNames {
+		if f&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
+
+// partialFields maps the NITES tokens to the fields they give.
+var partialFields = map[string]PartialField{
+	"yyyy": PartialYear, "yy": PartialYear,
+	"q": PartialQuarter, "qq": PartialQuarter,
+	"mmmm": PartialMonth, "mmm": PartialMonth, "mm": PartialMonth, "m": PartialMonth,
+	"ww": PartialWeek, "wi": PartialWeek,
+	"dd": PartialDay, "db": PartialDay, "d": PartialDay,
+	"ddd": PartialMonth | PartialDay, "dn": PartialMonth | PartialDay,
+	"hhhh": PartialHour, "hh": PartialHour, "h": PartialHour,
+	"ii": PartialMinute, "i": PartialMinute,
+	"ss": PartialSecond, "s": PartialSecond,
+	"unix":   PartialYear | PartialMonth | PartialDay | PartialHour | PartialMinute | PartialSecond,
+	"unixms": PartialYear | PartialMonth | PartialDay | PartialHour | PartialMinute | PartialSecond,
+}
+
+// partialLayouts are the layouts ParsePartial tries when none are given, from
+// the most to the least precise.
+var partialLayouts = []string{
+	"yyyy-mm-ddThhhh:ii:ss",
+	"yyyy-mm-dd hhhh:ii:ss",
+	"yyyy-mm-ddThhhh:ii",
+	"yyyy-mm-dd hhhh:ii",
+	"yyyy-mm-dd",
+	"yyyy-'W'wi",
+	"yyyy-mm",
+	"yyyy/mm",
+	"mm/yyyy",
+	"mmmm yyyy",
+	"mmm yyyy",
+	"yyyy-qq",
+	"qq yyyy",
+	"yyyy",
+}
+
+// PartialDate is a date read from a value that gives only some of its fields,
+// such as "2019-07" or "Q3 2019". It stands for the interval from Start to End
+// covered by the fields that were given: all of July 2019 for "2019-07".
+type PartialDate struct {
+	// Time is the parsed value, with the fields that weren't given set to
+	// their first value, as Parse sets them.
+	Time time.Time
+
+	// Fields are the fields given by the value.
+	Fields PartialField
+
+	// Precision is the period of the finest field given, such as PeriodMonth
+	// for "2019-07". Fractions of a second don't narrow it below a second.
+	Precision PeriodUnit
+
+	// Start is the first instant of the interval and End its last
+	// nanosecond, as given by MonthStart and MonthEnd for a month.
+	Start, End time.Time
+
+	// Layout is the layout that matched the value.
+	Layout string
+}
+
+// ParsePartial parses a value that may give only some fields of a date, such
+// as "2019", "2019-07", "Q3 2019" or "July 2019", trying each layout in turn
+// until one matches. When no layouts are given, it tries common ISO 8601 and
+// month-year layouts. The value is read in UTC.
+//
+// Example:
+//
+//	p, err := gotime.ParsePartial("July 2019")
+//	// p.Fields: year, month
+//	// p.Start:  2019-07-01 00:00:00 +0000 UTC
+//	// p.End:    2019-07-31 23:59:59.999999999 +0000 UTC
+//
+//	p, err = gotime.ParsePartial("FY2019-Q3", "'FY'yyyy-qq")
+//	// p.Precision: quarter
+func ParsePartial(value string, layouts ...string) (PartialDate, error) {
+	return ParsePartialInLocation(value, time.UTC, layouts...)
+}
+
+// ParsePartialInLocation is like ParsePartial but reads values without zone
+// information in loc.
+//
+// Example:
+//
+//	ist := time.FixedZone("IST", 5*60*60+30*60)
+//	p, err := gotime.ParsePartialInLocation("2019", ist)
+//	// p.Start: 2019-01-01 00:00:00 +0530 IST
+func ParsePartialInLocation(value string, loc *time.Location, layouts ...string) (PartialDate, error) {
+	if len(layouts) == 0 {
+		layouts = partialLayouts
+	}
+
+	var firstErr error
+	for _, layout := range layouts {
+		t, err := ParseInLocation(layout, value, loc)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		return newPartialDate(t, layout), nil
+	}
+
+	if len(layouts) == 1 {
+		return PartialDate{}, fmt.Errorf("%w: %q: %s", ErrInvalidPartialDate, value, firstErr)
+	}
+	return PartialDate{}, fmt.Errorf("%w: %q: matches none of %d layouts", ErrInvalidPartialDate, value, len(layouts))
+}
+
+// newPartialDate returns the partial date for t, parsed with layout.
+func newPartialDate(t time.Time, layout string) PartialDate {
+	p := PartialDate{Time: t, Layout: layout}
+	for _, tok := range nites.Tokenize(layout) {
+		p.Fields |= partialFields[tok.Value]
+	}
+
+	switch {
+	case p.Has(PartialSecond):
+		p.Precision = PeriodSecond
+		p.Start, p.End = PeriodStart(PeriodSecond, t), PeriodEnd(PeriodSecond, t)
+	case p.Has(PartialMinute):
+		p.Precision = PeriodMinute
+		p.Start, p.End = PeriodStart(PeriodMinute, t), PeriodEnd(PeriodMinute, t)
+	case p.Has(PartialHour):
+		p.Precision = PeriodHour
+		p.Start, p.End = PeriodStart(PeriodHour, t), PeriodEnd(PeriodHour, t)
+	case p.Has(PartialDay):
+		p.Precision = PeriodDay
+		p.Start, p.End = SoD(t), EoD(t)
+	case p.Has(PartialWeek):
+		p.Precision = PeriodWeek
+		p.Start, p.End = SoD(t), weekEnd(t, layout)
+	case p.Has(PartialMonth):
+		p.Precision = PeriodMonth
+		p.Start, p.End = MonthStart(t), MonthEnd(t)
+	case p.Has(PartialQuarter):
+		p.Precision = PeriodQuarter
+		p.Start, p.End = QuarterStart(t), QuarterEnd(t)
+	default:
+		p.Precision = PeriodYear
+		p.Start, p.End = YearStart(t), YearEnd(t)
+	}
+	return p
+}
+
+// weekEnd returns the last nanosecond of the week starting at t. An ISO week
+// ("wi") runs from Monday to Sunday; the other weeks ("ww") end on Saturday or
+// at the end of the year.
+func weekEnd(t time.Time, layout string) time.Time {
+	for _, tok := range nites.Tokenize(layout) {
+		if tok.Value == "wi" {
+			return EoD(t.AddDate(0, 0, 6))
+		}
+	}
+	if end := YearEnd(t); WeekEnd(t).After(end) {
+		return end
+	}
+	return WeekEnd(t)
+}
+
+// Has reports whether the value gave all of the fields.
+func (p PartialDate) Has(fields PartialField) bool {
+	return p.Fields&fields == fields
+}
+
+// Contains reports whether t falls in the interval of the partial date.
+//
+// Example:
+//
+//	p, _ := gotime.ParsePartial("2019-07")
+//	p.Contains(time.Date(2019, 7, 31, 18, 0, 0, 0, time.UTC)) // true
+func (p PartialDate) Contains(t time.Time) bool {
+	return !t.Before(p.Start) && !t.After(p.End)
+}
+
+// Overlaps reports whether the intervals of the two partial dates share an
+// instant, as "2019" and "2019-07" do.
+func (p PartialDate) Overlaps(other PartialDate) bool {
+	return !p.End.Before(other.Start) && !other.End.Before(p.Start)
+}
+
+// Before reports whether the interval of p ends before that of other starts.
+// "2019-06" is before "2019-07", but "2019" is neither before nor after
+// "2019-07".
+func (p PartialDate) Before(other PartialDate) bool {
+	return p.End.Before(other.Start)
+}
+
+// After reports whether the interval of p starts after that of other ends.
+func (p PartialDate) After(other PartialDate) bool {
+	return p.Start.After(other.End)
+}
+
+// String returns the start of the partial date in ISO 8601 form, reduced to
+// its precision, such as "2019", "2019-Q3", "2019-07" or "2019-07-04T15:04".
+func (p PartialDate) String() string {
+	switch p.Precision {
+	case PeriodYear:
+		return Format(p.Start, "yyyy")
+	case PeriodQuarter:
+		return Format(p.Start, "yyyy-qq")
+	case PeriodMonth:
+		return Format(p.Start, "yyyy-mm")
+	case PeriodWeek:
+		return Format(p.Start, "yyyy-mm-dd") + "/" + Format(p.End, "yyyy-mm-dd")
+	case PeriodDay:
+		return Format(p.Start, "yyyy-mm-dd")
+	case PeriodHour:
+		return Format(p.Start, "yyyy-mm-ddThhhh")
+	case PeriodMinute:
+		return Format(p.Start, "yyyy-mm-ddThhhh:ii")
+	}
+	return Format(p.Start, "yyyy-mm-ddThhhh:ii:ss")
+}
@@ -0,0 +1,133 @@
+package gotime_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestParsePartial(t *testing.T) {
+	date := func(y int, m time.Month, d, h, min, s, ns int) time.Time {
+		return time.Date(y, m, d, h, min, s, ns, time.UTC)
+	}
+
+	tests := []struct {
+		value      string
+		fields     gotime.PartialField
+		precision  gotime.PeriodUnit
+		start, end time.Time
+		str        string
+	}{
+		{"2019", gotime.PartialYear, gotime.PeriodYear,
+			date(2019, 1, 1, 0, 0, 0, 0), date(2019, 12, 31, 23, 59, 59, 999999999), "2019"},
+		{"Q3 2019", gotime.PartialYear | gotime.PartialQuarter, gotime.PeriodQuarter,
+			date(2019, 7, 1, 0, 0, 0, 0), date(2019, 9, 30, 23, 59, 59, 999999999), "2019-Q3"},
+		{"2019-Q4", gotime.PartialYear | gotime.PartialQuarter, gotime.PeriodQuarter,
+			date(2019, 10, 1, 0, 0, 0, 0), date(2019, 12, 31, 23, 59, 59, 999999999), "2019-Q4"},
+		{"2019-07", gotime.PartialYear | gotime.PartialMonth, gotime.PeriodMonth,
+			date(2019, 7, 1, 0, 0, 0, 0), date(2019, 7, 31, 23, 59, 59, 999999999), "2019-07"},
+		{"July 2019", gotime.PartialYear | gotime.PartialMonth, gotime.PeriodMonth,
+			date(2019, 7, 1, 0, 0, 0, 0), date(2019, 7, 31, 23, 59, 59, 999999999), "2019-07"},
+		{"Feb 2024", gotime.PartialYear | gotime.PartialMonth, gotime.PeriodMonth,
+			date(2024, 2, 1, 0, 0, 0, 0), date(2024, 2, 29, 23, 59, 59, 999999999), "2024-02"},
+		{"02/2024", gotime.PartialYear | gotime.PartialMonth, gotime.PeriodMonth,
+			date(2024, 2, 1, 0, 0, 0, 0), date(2024, 2, 29, 23, 59, 59, 999999999), "2024-02"},
+		{"2019-W27", gotime.PartialYear | gotime.PartialWeek, gotime.PeriodWeek,
+			date(2019, 7, 1, 0, 0, 0, 0), date(2019, 7, 7, 23, 59, 59, 999999999), "2019-07-01/2019-07-07"},
+		{"2019-07-04", gotime.PartialYear | gotime.PartialMonth | gotime.PartialDay, gotime.PeriodDay,
+			date(2019, 7, 4, 0, 0, 0, 0), date(2019, 7, 4, 23, 59, 59, 999999999), "2019-07-04"},
+		{"2019-07-04 15:04", gotime.PartialYear | gotime.PartialMonth | gotime.PartialDay | gotime.PartialHour | gotime.PartialMinute, gotime.PeriodMinute,
+			date(2019, 7, 4, 15, 4, 0, 0), date(2019, 7, 4, 15, 4, 59, 999999999), "2019-07-04T15:04"},
+		{"2019-07-04T15:04:05", gotime.PartialYear | gotime.PartialMonth | gotime.PartialDay | gotime.PartialHour | gotime.PartialMinute | gotime.PartialSecond, gotime.PeriodSecond,
+			date(2019, 7, 4, 15, 4, 5, 0), date(2019, 7, 4, 15, 4, 5, 999999999), "2019-07-04T15:04:05"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			p, err := gotime.ParsePartial(tc.value)
+			utils.AssertNoError(t, err)
+			utils.AssertEqual(t, tc.fields, p.Fields)
+			utils.AssertEqual(t, tc.precision, p.Precision)
+			utils.AssertEqual(t, tc.start, p.Start)
+			utils.AssertEqual(t, tc.end, p.End)
+			utils.AssertEqual(t, tc.str, p.String())
+		})
+	}
+}
+
+func TestParsePartialLayouts(t *testing.T) {
+	p, err := gotime.ParsePartial("FY2019-Q3", "'FY'yyyy-qq")
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, "'FY'yyyy-qq", p.Layout)
+	utils.AssertEqual(t, gotime.PeriodQuarter, p.Precision)
+	utils.AssertEqual(t, gotime.QuarterStart(time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC)), p.Start)
+
+	// The first layout that matches wins.
+	p, err = gotime.ParsePartial("2019 185", "yyyy-mm", "yyyy dn", "yyyy")
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, "yyyy dn", p.Layout)
+	utils.AssertEqual(t, true, p.Has(gotime.PartialMonth|gotime.PartialDay))
+	utils.AssertEqual(t, time.Date(2019, 7, 4, 0, 0, 0, 0, time.UTC), p.Start)
+
+	// Weeks that aren't ISO weeks end on Saturday or at the end of the year.
+	p, err = gotime.ParsePartial("2024 week 53", "yyyy 'week' ww")
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, time.Date(2024, 12, 29, 0, 0, 0, 0, time.UTC), p.Start)
+	utils.AssertEqual(t, gotime.YearEnd(p.Start), p.End)
+
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	p, err = gotime.ParsePartialInLocation("2019-07", ist)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, gotime.MonthStart(time.Date(2019, 7, 1, 0, 0, 0, 0, ist)), p.Start)
+	utils.AssertEqual(t, gotime.MonthEnd(time.Date(2019, 7, 1, 0, 0, 0, 0, ist)), p.End)
+	utils.AssertEqual(t, ist, p.End.Location())
+}
+
+func TestParsePartialErrors(t *testing.T) {
+	_, err := gotime.ParsePartial("sometime in 2019")
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrInvalidPartialDate))
+	utils.AssertEqual(t, `invalid partial date: "sometime in 2019": matches none of 14 layouts`, err.Error())
+
+	_, err = gotime.ParsePartial("2019-13", "yyyy-mm")
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrInvalidPartialDate))
+	utils.AssertEqual(t, `invalid partial date: "2019-13": parsing time "2019-13" as "yyyy-mm": month out of range`, err.Error())
+}
+
+func TestPartialDateCompare(t *testing.T) {
+	must := func(value string) gotime.PartialDate {
+		p, err := gotime.ParsePartial(value)
+		utils.AssertNoError(t, err)
+		return p
+	}
+	year, july, june, q3 := must("2019"), must("2019-07"), must("June 2019"), must("Q3 2019")
+
+	utils.AssertEqual(t, true, july.Contains(time.Date(2019, 7, 31, 23, 0, 0, 0, time.UTC)))
+	utils.AssertEqual(t, false, july.Contains(time.Date(2019, 8, 1, 0, 0, 0, 0, time.UTC)))
+	utils.AssertEqual(t, true, year.Overlaps(july))
+	utils.AssertEqual(t, true, q3.Overlaps(july))
+	utils.AssertEqual(t, false, june.Overlaps(q3))
+	utils.AssertEqual(t, true, june.Before(july))
+	utils.AssertEqual(t, true, july.After(june))
+	utils.AssertEqual(t, false, year.Before(july))
+	utils.AssertEqual(t, false, year.After(july))
+}
+
+func TestPartialFieldString(t *testing.T) {
+	utils.AssertEqual(t, "none", gotime.PartialField(0).String())
+	utils.AssertEqual(t, "year, quarter", (gotime.PartialYear | gotime.PartialQuarter).String())
+}
+
+func ExampleParsePartial() {
+	for _, value := range []string{"2019", "Q3 2019", "July 2019", "2019-07-04"} {
+		p, _ := gotime.ParsePartial(value)
+		fmt.Printf("%-10s %-21s %s to %s\n", value, p.Fields, p.Start.Format("2006-01-02"), p.End.Format("2006-01-02"))
+	}
+	// Output:
+	// 2019       year                  2019-01-01 to 2019-12-31
+	// Q3 2019    year, quarter         2019-07-01 to 2019-09-30
+	// July 2019  year, month           2019-07-01 to 2019-07-31
+	// 2019-07-04 year, month, day      2019-07-04 to 2019-07-04
+}
@@ -0,0 +1,345 @@
+package gotime
+
+import "time"
+
+// PeriodUnit identifies a calendar or clock period used by PeriodStart, PeriodEnd,
+// AddPeriods and PeriodIndex.
+//
+// Weeks start on Sunday, matching WeekStart. Dekads split every month into the
+// 1st-10th, the 11th-20th and the 21st to the end of the month. All month based
+// units (bimonths, quarters, trimesters and halves) are aligned to January.
+type PeriodUnit int
+
+const (
+	// PeriodSecond is a clock second.
+	PeriodSecond PeriodUnit = iota + 1
+	// PeriodMinute is a clock minute.
+	PeriodMinute
+	// PeriodHour is a clock hour.
+	PeriodHour
+	// PeriodDay is a calendar day.
+	PeriodDay
+	// PeriodWeek is a Sunday to Saturday week.
+	PeriodWeek
+	// PeriodDekad is a third of a month (10, 10 and 8 to 11 days).
+	PeriodDekad
+	// PeriodMonth is a calendar month.
+	PeriodMonth
+	// PeriodBimonth is a two month block (Jan-Feb, Mar-Apr, ...).
+	PeriodBimonth
+	// PeriodQuarter is a three month block (Jan-Mar, Apr-Jun, ...).
+	PeriodQuarter
+	// PeriodTrimester is a four month block (Jan-Apr, May-Aug, Sep-Dec).
+	PeriodTrimester
+	// PeriodHalf is a six month block (Jan-Jun, Jul-Dec).
+	PeriodHalf
+	// PeriodYear is a calendar year.
+	PeriodYear
+)
+
+// PeriodSemester is an alias of PeriodHalf.
+const PeriodSemester = PeriodHalf
+
+// String returns the lower case name of the unit, such as "day" or "quarter".
+func (u PeriodUnit) String() string {
+	switch u {
+	case PeriodSecond:
+		return "second"
+	case PeriodMinute:
+		return "minute"
+	case PeriodHour:
+		return "hour"
+	case PeriodDay:
+		return "day"
+	case PeriodWeek:
+		return "week"
+	case PeriodDekad:
+		return "dekad"
+	case PeriodMonth:
+		return "month"
+	case PeriodBimonth:
+		return "bimonth"
+	case PeriodQuarter:
+		return "quarter"
+	case PeriodTrimester:
+		return "trimester"
+	case PeriodHalf:
+		return "half"
+	case PeriodYear:
+		return "year"
+	}
+	return "unknown"
+}
+
+// clockUnit returns the fixed length of the sub-day units.
+func (u PeriodUnit) clockUnit() (time.Duration, bool) {
+	switch u {
+	case PeriodSecond:
+		return time.Second, true
+	case PeriodMinute:
+		return time.Minute, true
+	case PeriodHour:
+		return time.Hour, true
+	}
+	return 0, false
+}
+
+// monthSpan returns the number of months covered by the month based units.
+func (u PeriodUnit) monthSpan() (int, bool) {
+	switch u {
+	case PeriodMonth:
+		return 1, true
+	case PeriodBimonth:
+		return 2, true
+	case PeriodQuarter:
+		return 3, true
+	case PeriodTrimester:
+		return 4, true
+	case PeriodHalf:
+		return 6, true
+	case PeriodYear:
+		return 12, true
+	}
+	return 0, false
+}
+
+// PeriodStart returns the first instant of the period of the given unit that
+// contains the given time. The location of the time is preserved, and calendar
+// periods start at the first instant of their first day even when midnight is
+// skipped by a DST transition.
+// If no time is provided, it uses the current time. Unknown units return the
+// time unchanged.
+//
+// Example:
+//
+//	t := time.Date(2025, 8, 15, 10, 30, 0, 0, time.UTC)
+//	gotime.PeriodStart(gotime.PeriodHalf, t)    // 2025-07-01 00:00:00
+//	gotime.PeriodStart(gotime.PeriodBimonth, t) // 2025-07-01 00:00:00
+//	gotime.PeriodStart(gotime.PeriodDekad, t)   // 2025-08-11 00:00:00
+func PeriodStart(unit PeriodUnit, dt ...time.Time) time.Time {
+	var t time.Time
+	if len(dt) > 0 {
+		t = dt[0]
+	} else {
+		t = Now()
+	}
+
+	if d, ok := unit.clockUnit(); ok {
+		// Subtract the elapsed part instead of rebuilding the wall clock so the
+		// result stays on the same side of a DST transition.
+		elapsed := time.Duration(t.Nanosecond())
+		if d > time.Second {
+			elapsed += time.Duration(t.Second()) * time.Second
+		}
+		if d > time.Minute {
+			elapsed += time.Duration(t.Minute()) * time.Minute
+		}
+		return t.Add(-elapsed)
+	}
+
+	if span, ok := unit.monthSpan(); ok {
+		month := (int(t.Month())-1)/span*span + 1
+		return startOfDay(t.Year(), time.Month(month), 1, t.Location())
+	}
+
+	switch unit {
+	case PeriodDay:
+		return startOfDay(t.Year(), t.Month(), t.Day(), t.Location())
+	case PeriodWeek:
+		return startOfDay(t.Year(), t.Month(), t.Day()-int(t.Weekday()), t.Location())
+	case PeriodDekad:
+		return startOfDay(t.Year(), t.Month(), dekadFirstDay(t.Day()), t.Location())
+	}
+	return t
+}
+
+// PeriodEnd returns the last nanosecond of the period of the given unit that
+// contains the given time. The location of the time is preserved, and calendar
+// periods end one nanosecond before the next period starts even when the last
+// hour of the day repeats because of a DST transition.
+// If no time is provided, it uses the current time. Unknown units return the
+// time unchanged.
+//
+// Example:
+//
+//	t := time.Date(2025, 2, 25, 10, 30, 0, 0, time.UTC)
+//	gotime.PeriodEnd(gotime.PeriodDekad, t)   // 2025-02-28 23:59:59.999999999
+//	gotime.PeriodEnd(gotime.PeriodBimonth, t) // 2025-02-28 23:59:59.999999999
+//	gotime.PeriodEnd(gotime.PeriodHalf, t)    // 2025-06-30 23:59:59.999999999
+func PeriodEnd(unit PeriodUnit, dt ...time.Time) time.Time {
+	var t time.Time
+	if len(dt) > 0 {
+		t = dt[0]
+	} else {
+		t = Now()
+	}
+
+	if d, ok := unit.clockUnit(); ok {
+		return PeriodStart(unit, t).Add(d - 1)
+	}
+
+	if span, ok := unit.monthSpan(); ok {
+		month := (int(t.Month())-1)/span*span + 1
+		return endOfDay(t.Year(), time.Month(month+span), 0, t.Location())
+	}
+
+	switch unit {
+	case PeriodDay:
+		return endOfDay(t.Year(), t.Month(), t.Day(), t.Location())
+	case PeriodWeek:
+		return endOfDay(t.Year(), t.Month(), t.Day()+6-int(t.Weekday()), t.Location())
+	case PeriodDekad:
+		last := dekadFirstDay(t.Day()) + 9
+		if last > 20 {
+			last = DaysInMonth(t.Year(), int(t.Month()))
+		}
+		return endOfDay(t.Year(), t.Month(), last, t.Location())
+	}
+	return t
+}
+
+// AddPeriods returns the time after adding n periods of the given unit to the
+// given time. Negative values subtract periods. If no time is provided, it uses
+// the current time. If n is 0, it returns the original time unchanged.
+//
+// Clock units add an exact duration. Calendar units keep the wall clock time and
+// use time.AddDate, so adding a month to January 31st overflows into March like
+// Months does. Dekads keep the day offset within the dekad, clamped to the length
+// of the target dekad.
+//
+// Example:
+//
+//	t := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+//	gotime.AddPeriods(gotime.PeriodHalf, 1, t)   // 2025-07-15 09:00:00
+//	gotime.AddPeriods(gotime.PeriodDekad, -2, t) // 2024-12-25 09:00:00
+func AddPeriods(unit PeriodUnit, n int, dt ...time.Time) time.Time {
+	var t time.Time
+	if len(dt) > 0 {
+		t = dt[0]
+	} else {
+		t = Now()
+	}
+	if n == 0 {
+		return t
+	}
+
+	if d, ok := unit.clockUnit(); ok {
+		return t.Add(time.Duration(n) * d)
+	}
+
+	if span, ok := unit.monthSpan(); ok {
+		return t.AddDate(0, n*span, 0)
+	}
+
+	switch unit {
+	case PeriodDay:
+		return t.AddDate(0, 0, n)
+	case PeriodWeek:
+		return t.AddDate(0, 0, n*7)
+	case PeriodDekad:
+		first := dekadFirstDay(t.Day())
+		offset := t.Day() - first
+
+		// Dekads are numbered from year zero so that the month and year
+		// carry fall out of integer division.
+		index := t.Year()*36 + (int(t.Month())-1)*3 + (first-1)/10 + n
+		year, rem := floorDivMod(index, 36)
+		month := rem/3 + 1
+		first = rem%3*10 + 1
+
+		last := first + 9
+		if first == 21 {
+			last = DaysInMonth(year, month)
+		}
+		day := first + offset
+		if day > last {
+			day = last
+		}
+		return time.Date(year, time.Month(month), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	}
+	return t
+}
+
+// PeriodIndex returns the 1-based position, within its calendar year, of the
+// period of the given unit that contains the given time. For PeriodYear it
+// returns the year itself. If no time is provided, it uses the current time.
+// Unknown units return 0.
+//
+// Weeks are counted like WeekOfMonth counts them within a month: the first week
+// is the (possibly partial) week that contains January 1st.
+//
+// Example:
+//
+//	t := time.Date(2025, 8, 15, 0, 0, 0, 0, time.UTC)
+//	gotime.PeriodIndex(gotime.PeriodQuarter, t) // 3
+//	gotime.PeriodIndex(gotime.PeriodBimonth, t) // 4
+//	gotime.PeriodIndex(gotime.PeriodDekad, t)   // 23
+func PeriodIndex(unit PeriodUnit, dt ...time.Time) int {
+	var t time.Time
+	if len(dt) > 0 {
+		t = dt[0]
+	} else {
+		t = Now()
+	}
+
+	if unit == PeriodYear {
+		return t.Year()
+	}
+	if span, ok := unit.monthSpan(); ok {
+		return (int(t.Month())-1)/span + 1
+	}
+
+	day := t.YearDay() - 1
+	switch unit {
+	case PeriodSecond:
+		return ((day*24+t.Hour())*60+t.Minute())*60 + t.Second() + 1
+	case PeriodMinute:
+		return (day*24+t.Hour())*60 + t.Minute() + 1
+	case PeriodHour:
+		return day*24 + t.Hour() + 1
+	case PeriodDay:
+		return day + 1
+	case PeriodWeek:
+		jan1 := time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location())
+		return (day+int(jan1.Weekday()))/7 + 1
+	case PeriodDekad:
+		return (int(t.Month())-1)*3 + (dekadFirstDay(t.Day())-1)/10 + 1
+	}
+	return 0
+}
+
+// startOfDay returns the first instant of the given calendar day in loc. When
+// midnight falls into a DST gap, this is the first instant after the gap.
+// Out of range days are normalized like time.Date does.
+func startOfDay(year int, month time.Month, day int, loc *time.Location) time.Time {
+	t, _ := DateInLocation(loc, year, int(month), day, 0, 0, 0, 0, DSTShiftForward)
+	return t
+}
+
+// endOfDay returns the last instant of the given calendar day in loc. On days
+// whose final hour repeats, this is the end of the second occurrence.
+func endOfDay(year int, month time.Month, day int, loc *time.Location) time.Time {
+	return startOfDay(year, month, day+1, loc).Add(-1)
+}
+
+// dekadFirstDay returns the first day of the dekad that contains the given day of month.
+func dekadFirstDay(day int) int {
+	switch {
+	case day <= 10:
+		return 1
+	case day <= 20:
+		return 11
+	default:
+		return 21
+	}
+}
+
+// floorDivMod returns the floored quotient and the non-negative remainder of a / b.
+func floorDivMod(a, b int) (q, r int) {
+	q, r = a/b, a%b
+	if r < 0 {
+		q--
+		r += b
+	}
+	return q, r
+}
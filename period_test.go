@@ -0,0 +1,150 @@
+package gotime_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestPeriodStartEnd(t *testing.T) {
+	ref := time.Date(2025, 8, 15, 10, 30, 45, 123, time.UTC)
+	tests := []struct {
+		unit  gotime.PeriodUnit
+		start time.Time
+		end   time.Time
+	}{
+		{gotime.PeriodSecond, time.Date(2025, 8, 15, 10, 30, 45, 0, time.UTC), time.Date(2025, 8, 15, 10, 30, 45, 999999999, time.UTC)},
+		{gotime.PeriodMinute, time.Date(2025, 8, 15, 10, 30, 0, 0, time.UTC), time.Date(2025, 8, 15, 10, 30, 59, 999999999, time.UTC)},
+		{gotime.PeriodHour, time.Date(2025, 8, 15, 10, 0, 0, 0, time.UTC), time.Date(2025, 8, 15, 10, 59, 59, 999999999, time.UTC)},
+		{gotime.PeriodDay, time.Date(2025, 8, 15, 0, 0, 0, 0, time.UTC), time.Date(2025, 8, 15, 23, 59, 59, 999999999, time.UTC)},
+		{gotime.PeriodWeek, time.Date(2025, 8, 10, 0, 0, 0, 0, time.UTC), time.Date(2025, 8, 16, 23, 59, 59, 999999999, time.UTC)},
+		{gotime.PeriodDekad, time.Date(2025, 8, 11, 0, 0, 0, 0, time.UTC), time.Date(2025, 8, 20, 23, 59, 59, 999999999, time.UTC)},
+		{gotime.PeriodMonth, time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 8, 31, 23, 59, 59, 999999999, time.UTC)},
+		{gotime.PeriodBimonth, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 8, 31, 23, 59, 59, 999999999, time.UTC)},
+		{gotime.PeriodQuarter, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 9, 30, 23, 59, 59, 999999999, time.UTC)},
+		{gotime.PeriodTrimester, time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 8, 31, 23, 59, 59, 999999999, time.UTC)},
+		{gotime.PeriodHalf, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{gotime.PeriodYear, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.unit.String(), func(t *testing.T) {
+			utils.AssertEqual(t, tc.start, gotime.PeriodStart(tc.unit, ref))
+			utils.AssertEqual(t, tc.end, gotime.PeriodEnd(tc.unit, ref))
+
+			// Every instant of the period maps to the same boundaries.
+			utils.AssertEqual(t, tc.start, gotime.PeriodStart(tc.unit, tc.start))
+			utils.AssertEqual(t, tc.start, gotime.PeriodStart(tc.unit, tc.end))
+			utils.AssertEqual(t, tc.end, gotime.PeriodEnd(tc.unit, tc.start))
+			utils.AssertEqual(t, tc.end, gotime.PeriodEnd(tc.unit, tc.end))
+		})
+	}
+}
+
+func TestPeriodDekadBoundaries(t *testing.T) {
+	tests := []struct {
+		date  time.Time
+		start int
+		end   int
+	}{
+		{time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), 1, 10},
+		{time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), 1, 10},
+		{time.Date(2025, 2, 11, 0, 0, 0, 0, time.UTC), 11, 20},
+		{time.Date(2025, 2, 21, 0, 0, 0, 0, time.UTC), 21, 28},
+		{time.Date(2024, 2, 25, 0, 0, 0, 0, time.UTC), 21, 29},
+		{time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), 21, 31},
+	}
+	for _, tc := range tests {
+		utils.AssertEqual(t, tc.start, gotime.PeriodStart(gotime.PeriodDekad, tc.date).Day())
+		utils.AssertEqual(t, tc.end, gotime.PeriodEnd(gotime.PeriodDekad, tc.date).Day())
+	}
+}
+
+func TestAddPeriods(t *testing.T) {
+	ref := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		unit     gotime.PeriodUnit
+		n        int
+		expected time.Time
+	}{
+		{gotime.PeriodSecond, 90, time.Date(2025, 1, 15, 9, 1, 30, 0, time.UTC)},
+		{gotime.PeriodMinute, -30, time.Date(2025, 1, 15, 8, 30, 0, 0, time.UTC)},
+		{gotime.PeriodHour, 24, time.Date(2025, 1, 16, 9, 0, 0, 0, time.UTC)},
+		{gotime.PeriodDay, -15, time.Date(2024, 12, 31, 9, 0, 0, 0, time.UTC)},
+		{gotime.PeriodWeek, 2, time.Date(2025, 1, 29, 9, 0, 0, 0, time.UTC)},
+		{gotime.PeriodDekad, 1, time.Date(2025, 1, 25, 9, 0, 0, 0, time.UTC)},
+		{gotime.PeriodDekad, -2, time.Date(2024, 12, 25, 9, 0, 0, 0, time.UTC)},
+		{gotime.PeriodDekad, 36, time.Date(2026, 1, 15, 9, 0, 0, 0, time.UTC)},
+		{gotime.PeriodMonth, 1, time.Date(2025, 2, 15, 9, 0, 0, 0, time.UTC)},
+		{gotime.PeriodBimonth, 1, time.Date(2025, 3, 15, 9, 0, 0, 0, time.UTC)},
+		{gotime.PeriodQuarter, -1, time.Date(2024, 10, 15, 9, 0, 0, 0, time.UTC)},
+		{gotime.PeriodTrimester, 1, time.Date(2025, 5, 15, 9, 0, 0, 0, time.UTC)},
+		{gotime.PeriodHalf, 1, time.Date(2025, 7, 15, 9, 0, 0, 0, time.UTC)},
+		{gotime.PeriodYear, -2, time.Date(2023, 1, 15, 9, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%s %d", tc.unit, tc.n), func(t *testing.T) {
+			utils.AssertEqual(t, tc.expected, gotime.AddPeriods(tc.unit, tc.n, ref))
+		})
+	}
+
+	// Zero returns the input unchanged
+	utils.AssertEqual(t, ref, gotime.AddPeriods(gotime.PeriodMonth, 0, ref))
+
+	// The day offset within a dekad is clamped to the target dekad
+	utils.AssertEqual(t,
+		time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC),
+		gotime.AddPeriods(gotime.PeriodDekad, 3, time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestPeriodIndex(t *testing.T) {
+	ref := time.Date(2025, 8, 15, 10, 30, 0, 0, time.UTC)
+	tests := []struct {
+		unit     gotime.PeriodUnit
+		expected int
+	}{
+		{gotime.PeriodHour, 226*24 + 11},
+		{gotime.PeriodDay, 227},
+		{gotime.PeriodWeek, 33},
+		{gotime.PeriodDekad, 23},
+		{gotime.PeriodMonth, 8},
+		{gotime.PeriodBimonth, 4},
+		{gotime.PeriodQuarter, 3},
+		{gotime.PeriodTrimester, 2},
+		{gotime.PeriodHalf, 2},
+		{gotime.PeriodYear, 2025},
+	}
+	for _, tc := range tests {
+		t.Run(tc.unit.String(), func(t *testing.T) {
+			utils.AssertEqual(t, tc.expected, gotime.PeriodIndex(tc.unit, ref))
+		})
+	}
+
+	// January 1st, 2025 is a Wednesday, so Sunday the 5th starts week 2
+	utils.AssertEqual(t, 1, gotime.PeriodIndex(gotime.PeriodWeek, time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC)))
+	utils.AssertEqual(t, 2, gotime.PeriodIndex(gotime.PeriodWeek, time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)))
+	utils.AssertEqual(t, 0, gotime.PeriodIndex(gotime.PeriodUnit(0), ref))
+}
+
+func TestPeriodExistingHelpersAreThinCases(t *testing.T) {
+	ref := time.Date(2024, 2, 29, 18, 45, 0, 0, time.FixedZone("EST", -5*3600))
+	utils.AssertEqual(t, gotime.YearStart(ref), gotime.PeriodStart(gotime.PeriodYear, ref))
+	utils.AssertEqual(t, gotime.MonthEnd(ref), gotime.PeriodEnd(gotime.PeriodMonth, ref))
+	utils.AssertEqual(t, gotime.WeekStart(ref), gotime.PeriodStart(gotime.PeriodWeek, ref))
+	utils.AssertEqual(t, gotime.QuarterEnd(ref), gotime.PeriodEnd(gotime.PeriodQuarter, ref))
+	utils.AssertEqual(t, gotime.SoD(ref), gotime.PeriodStart(gotime.PeriodDay, ref))
+	utils.AssertEqual(t, gotime.Quarters(2, ref), gotime.AddPeriods(gotime.PeriodQuarter, 2, ref))
+	utils.AssertEqual(t, gotime.QuarterOfYear(ref), gotime.PeriodIndex(gotime.PeriodQuarter, ref))
+	utils.AssertEqual(t, ref.Location(), gotime.PeriodStart(gotime.PeriodHalf, ref).Location())
+}
+
+func ExamplePeriodStart() {
+	t := time.Date(2025, 8, 15, 10, 30, 0, 0, time.UTC)
+	fmt.Println(gotime.PeriodStart(gotime.PeriodHalf, t))
+	fmt.Println(gotime.PeriodEnd(gotime.PeriodDekad, t))
+	// Output:
+	// 2025-07-01 00:00:00 +0000 UTC
+	// 2025-08-20 23:59:59.999999999 +0000 UTC
+}
@@ -25,8 +25,9 @@ func IsBetween(t1, t2, t3 time.Time) bool {
 }
 
 // IsBetweenDates reports whether t1 falls within the date range defined by t2 and t3 (inclusive).
-// Unlike IsBetween, this function compares only the date portion by normalizing t2 to
-// start-of-day and t3 to end-of-day before comparison.
+// Unlike IsBetween, this function compares only the calendar dates. All three times
+// are read in the location of t1, so a UTC bound is not compared against a local
+// target by its UTC date. The order of t2 and t3 doesn't matter.
 //
 // Example:
 //	target := time.Date(2025, 7, 5, 23, 30, 0, 0, time.UTC)
@@ -35,14 +36,103 @@ func IsBetween(t1, t2, t3 time.Time) bool {
 //	result := gotime.IsBetweenDates(target, start, end)
 //	// result: true (all dates are July 5th, regardless of time)
 func IsBetweenDates(t1, t2, t3 time.Time) bool {
-	sec1 := SoD(t1).Unix()
-	sec2 := SoD(t2).Unix()
-	sec3 := EoD(t3).Unix()
+	return IsBetweenDatesIn(t1, t2, t3, t1.Location())
+}
+
+// IsBetweenDatesIn is like IsBetweenDates but reads the calendar dates of all three
+// times in loc. A nil loc uses the location of t1.
+//
+// Example:
+//	kolkata, _ := gotime.LoadLocation("Asia/Kolkata")
+//	target := time.Date(2025, 7, 5, 20, 0, 0, 0, time.UTC) // July 6th 01:30 in Kolkata
+//	start := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+//	end := time.Date(2025, 7, 5, 0, 0, 0, 0, time.UTC)
+//	result := gotime.IsBetweenDatesIn(target, start, end, kolkata)
+//	// result: false
+func IsBetweenDatesIn(t1, t2, t3 time.Time, loc *time.Location) bool {
+	if loc == nil {
+		loc = t1.Location()
+	}
+	day := PeriodStart(PeriodDay, t1.In(loc))
+	start := PeriodStart(PeriodDay, t2.In(loc))
+	end := PeriodStart(PeriodDay, t3.In(loc))
+
+	// Swapping the values if start is after end
+	if start.After(end) {
+		start, end = end, start
+	}
 
-	// Swapping the values if sec2 is greater than sec3
-	if sec2 > sec3 {
-		sec2, sec3 = sec3, sec2
+	return !day.Before(start) && !day.After(end)
+}
+
+// SamePeriod reports whether a and b fall within the same period of the given unit
+// when both are read in loc. A nil loc uses the location of a, so b is converted
+// to the zone of a before comparing.
+//
+// Example:
+//	a := time.Date(2025, 8, 1, 9, 0, 0, 0, time.UTC)
+//	b := time.Date(2025, 9, 30, 18, 0, 0, 0, time.UTC)
+//	gotime.SamePeriod(gotime.PeriodQuarter, a, b, time.UTC) // true
+func SamePeriod(unit PeriodUnit, a, b time.Time, loc *time.Location) bool {
+	if loc == nil {
+		loc = a.Location()
 	}
+	return PeriodStart(unit, a.In(loc)).Equal(PeriodStart(unit, b.In(loc)))
+}
 
-	return sec1 >= sec2 && sec1 <= sec3
+// SameDay reports whether a and b fall on the same calendar day in loc.
+// A nil loc uses the location of a.
+//
+// Example:
+//	ny, _ := gotime.LoadLocation("America/New_York")
+//	a := time.Date(2025, 7, 4, 2, 0, 0, 0, time.UTC)   // July 3rd 22:00 in New York
+//	b := time.Date(2025, 7, 4, 0, 0, 0, 0, ny)
+//	gotime.SameDay(a, b, time.UTC) // true
+//	gotime.SameDay(a, b, ny)       // false
+func SameDay(a, b time.Time, loc *time.Location) bool {
+	return SamePeriod(PeriodDay, a, b, loc)
+}
+
+// SameWeek reports whether a and b fall within the same Sunday to Saturday week
+// in loc. A nil loc uses the location of a.
+//
+// Example:
+//	a := time.Date(2025, 7, 6, 0, 0, 0, 0, time.UTC)  // Sunday
+//	b := time.Date(2025, 7, 12, 0, 0, 0, 0, time.UTC) // Saturday
+//	gotime.SameWeek(a, b, time.UTC) // true
+func SameWeek(a, b time.Time, loc *time.Location) bool {
+	return SamePeriod(PeriodWeek, a, b, loc)
+}
+
+// SameMonth reports whether a and b fall within the same calendar month in loc.
+// A nil loc uses the location of a.
+//
+// Example:
+//	a := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+//	b := time.Date(2025, 7, 31, 23, 0, 0, 0, time.UTC)
+//	gotime.SameMonth(a, b, time.UTC) // true
+func SameMonth(a, b time.Time, loc *time.Location) bool {
+	return SamePeriod(PeriodMonth, a, b, loc)
+}
+
+// SameQuarter reports whether a and b fall within the same calendar quarter in loc.
+// A nil loc uses the location of a.
+//
+// Example:
+//	a := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+//	b := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+//	gotime.SameQuarter(a, b, time.UTC) // true
+func SameQuarter(a, b time.Time, loc *time.Location) bool {
+	return SamePeriod(PeriodQuarter, a, b, loc)
+}
+
+// SameYear reports whether a and b fall within the same calendar year in loc.
+// A nil loc uses the location of a.
+//
+// Example:
+//	a := time.Date(2024, 12, 31, 23, 0, 0, 0, time.UTC)
+//	b := time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC)
+//	gotime.SameYear(a, b, time.UTC) // false
+func SameYear(a, b time.Time, loc *time.Location) bool {
+	return SamePeriod(PeriodYear, a, b, loc)
 }
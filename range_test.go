@@ -59,3 +59,61 @@ func TestIsBetweenDates(t *testing.T) {
 	expected = true
 	utils.AssertEqual(t, expected, result)
 }
+
+func TestIsBetweenDatesAcrossZones(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	// 2025-07-04 02:00 UTC is still July 3rd in New York.
+	target := time.Date(2025, 7, 4, 2, 0, 0, 0, time.UTC)
+	july4 := time.Date(2025, 7, 4, 0, 0, 0, 0, ny)
+	july10 := time.Date(2025, 7, 10, 0, 0, 0, 0, ny)
+
+	utils.AssertEqual(t, true, gotime.IsBetweenDates(target, july4, july10))
+	utils.AssertEqual(t, false, gotime.IsBetweenDates(target.In(ny), july4, july10))
+	utils.AssertEqual(t, false, gotime.IsBetweenDatesIn(target, july4, july10, ny))
+	utils.AssertEqual(t, true, gotime.IsBetweenDatesIn(target, july4, july10, nil))
+
+	// Reversed bounds include both boundary days.
+	start := time.Date(2025, 7, 1, 23, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 7, 5, 1, 0, 0, 0, time.UTC)
+	utils.AssertEqual(t, true, gotime.IsBetweenDates(time.Date(2025, 7, 5, 22, 0, 0, 0, time.UTC), end, start))
+	utils.AssertEqual(t, true, gotime.IsBetweenDates(time.Date(2025, 7, 1, 1, 0, 0, 0, time.UTC), end, start))
+	utils.AssertEqual(t, false, gotime.IsBetweenDates(time.Date(2025, 7, 6, 0, 0, 0, 0, time.UTC), end, start))
+}
+
+func TestSamePeriodHelpers(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	kolkata := mustLoad(t, "Asia/Kolkata")
+
+	tests := []struct {
+		name string
+		fn   func(a, b time.Time, loc *time.Location) bool
+		a, b time.Time
+		loc  *time.Location
+		want bool
+	}{
+		{"Day same UTC", gotime.SameDay, time.Date(2025, 7, 4, 2, 0, 0, 0, time.UTC), time.Date(2025, 7, 4, 0, 0, 0, 0, ny), time.UTC, true},
+		{"Day differs in NY", gotime.SameDay, time.Date(2025, 7, 4, 2, 0, 0, 0, time.UTC), time.Date(2025, 7, 4, 0, 0, 0, 0, ny), ny, false},
+		{"Day nil uses a", gotime.SameDay, time.Date(2025, 7, 3, 22, 0, 0, 0, ny), time.Date(2025, 7, 4, 1, 0, 0, 0, time.UTC), nil, true},
+		{"Day differs in Kolkata", gotime.SameDay, time.Date(2025, 7, 4, 10, 0, 0, 0, time.UTC), time.Date(2025, 7, 4, 19, 0, 0, 0, time.UTC), kolkata, false},
+		{"Week Sunday to Saturday", gotime.SameWeek, time.Date(2025, 7, 6, 0, 0, 0, 0, time.UTC), time.Date(2025, 7, 12, 23, 0, 0, 0, time.UTC), time.UTC, true},
+		{"Week crosses Sunday", gotime.SameWeek, time.Date(2025, 7, 5, 23, 0, 0, 0, time.UTC), time.Date(2025, 7, 6, 1, 0, 0, 0, time.UTC), time.UTC, false},
+		{"Week same in NY", gotime.SameWeek, time.Date(2025, 7, 5, 23, 0, 0, 0, time.UTC), time.Date(2025, 7, 6, 1, 0, 0, 0, time.UTC), ny, true},
+		{"Month", gotime.SameMonth, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 7, 31, 23, 0, 0, 0, time.UTC), time.UTC, true},
+		{"Month boundary in Kolkata", gotime.SameMonth, time.Date(2025, 7, 31, 20, 0, 0, 0, time.UTC), time.Date(2025, 7, 31, 10, 0, 0, 0, time.UTC), kolkata, false},
+		{"Quarter", gotime.SameQuarter, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC), time.UTC, true},
+		{"Quarter differs", gotime.SameQuarter, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), time.UTC, false},
+		{"Year differs", gotime.SameYear, time.Date(2024, 12, 31, 23, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC), time.UTC, false},
+		{"Year same in NY", gotime.SameYear, time.Date(2024, 12, 31, 23, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC), ny, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			utils.AssertEqual(t, tc.want, tc.fn(tc.a, tc.b, tc.loc))
+		})
+	}
+
+	a := time.Date(2025, 8, 15, 0, 0, 0, 0, time.UTC)
+	b := time.Date(2025, 8, 20, 0, 0, 0, 0, time.UTC)
+	utils.AssertEqual(t, true, gotime.SamePeriod(gotime.PeriodDekad, a, b, nil))
+	utils.AssertEqual(t, false, gotime.SamePeriod(gotime.PeriodDekad, a, b.AddDate(0, 0, 1), nil))
+}
@@ -0,0 +1,828 @@
+package gotime
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidRule is returned when a recurrence rule or a recurrence set cannot
+// be parsed.
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+// Frequency is the FREQ of a recurrence rule.
+type Frequency int
+
+const (
+	// FrequencySecondly repeats every second.
+	FrequencySecondly Frequency = iota + 1
+	// FrequencyMinutely repeats every minute.
+	FrequencyMinutely
+	// FrequencyHourly repeats every hour.
+	FrequencyHourly
+	// FrequencyDaily repeats every day.
+	FrequencyDaily
+	// FrequencyWeekly repeats every week.
+	FrequencyWeekly
+	// FrequencyMonthly repeats every month.
+	FrequencyMonthly
+	// FrequencyYearly repeats every year.
+	FrequencyYearly
+)
+
+// frequencyNames are the RRULE names of the frequencies.
+var frequencyNames = [...]string{
+	FrequencySecondly: "SECONDLY",
+	FrequencyMinutely: "MINUTELY",
+	FrequencyHourly:   "HOURLY",
+	FrequencyDaily:    "DAILY",
+	FrequencyWeekly:   "WEEKLY",
+	FrequencyMonthly:  "MONTHLY",
+	FrequencyYearly:   "YEARLY",
+}
+
+// frequencySteps are the lengths of the clock frequencies.
+var frequencySteps = [...]time.Duration{
+	FrequencySecondly: time.Second,
+	FrequencyMinutely: time.Minute,
+	FrequencyHourly:   time.Hour,
+}
+
+// String returns the RRULE name of the frequency, such as "WEEKLY".
+func (f Frequency) String() string {
+	if f >= FrequencySecondly && f <= FrequencyYearly {
+		return frequencyNames[f]
+	}
+	return "Frequency(" + strconv.Itoa(int(f)) + ")"
+}
+
+// ruleWeekdays are the RRULE codes of the weekdays.
+var ruleWeekdays = [...]string{
+	time.Sunday:    "SU",
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+}
+
+// parseRuleWeekday returns the weekday for an RRULE weekday code.
+func parseRuleWeekday(code string) (time.Weekday, bool) {
+	for day, c := range ruleWeekdays {
+		if c == code {
+			return time.Weekday(day), true
+		}
+	}
+	return 0, false
+}
+
+// WeekdayNum is a BYDAY entry of a recurrence rule: a weekday with an optional
+// ordinal, such as "2MO" (the second Monday) or "-1FR" (the last Friday). N is 0
+// for every occurrence of the weekday.
+type WeekdayNum struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// String returns the RRULE form of the entry, such as "MO" or "-1FR".
+func (w WeekdayNum) String() string {
+	if w.N == 0 {
+		return ruleWeekdays[w.Weekday]
+	}
+	return strconv.Itoa(w.N) + ruleWeekdays[w.Weekday]
+}
+
+// Rule is an iCalendar (RFC 5545) recurrence rule such as
+// "FREQ=MONTHLY;BYDAY=-1FR;COUNT=6". It describes the pattern only; the first
+// occurrence is set by Recurrence.Start.
+//
+// BYDAY ordinals count within the month for monthly rules and for yearly rules
+// with BYMONTH, and within the year for other yearly rules. Dates that don't
+// exist, such as February 30th, are skipped.
+type Rule struct {
+	Freq Frequency
+
+	// Interval is the number of periods between occurrences. 0 is treated as 1.
+	Interval int
+
+	// Count limits the number of occurrences, including the start. 0 means no
+	// limit.
+	Count int
+
+	// Until is the last time an occurrence may fall on (inclusive). The zero
+	// time means no limit.
+	Until time.Time
+
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []time.Month
+	BySetPos   []int
+
+	// WeekStart is the first day of the week (WKST). It decides which weeks a
+	// weekly rule with an interval greater than 1 skips. ParseRule defaults it
+	// to Monday, as RFC 5545 does.
+	WeekStart time.Weekday
+}
+
+// ParseRule parses an RRULE value such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE",
+// with or without the "RRULE:" prefix. Names are case insensitive.
+//
+// FREQ, INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY, BYMONTH, BYSETPOS and WKST are
+// supported; other parts are rejected. An UNTIL without a "Z" suffix is read in
+// UTC, and an UNTIL date includes the whole day. Use ParseRecurrence to read
+// them in the zone of the start.
+//
+// Example:
+//
+//	r, err := gotime.ParseRule("FREQ=MONTHLY;BYDAY=1FR;COUNT=10")
+//	// r: Rule{Freq: FrequencyMonthly, Count: 10, ByDay: []WeekdayNum{{1, time.Friday}}, WeekStart: time.Monday}
+func ParseRule(s string) (Rule, error) {
+	return parseRule(s, time.UTC)
+}
+
+// parseRule parses an RRULE value, reading a floating UNTIL in loc.
+func parseRule(s string, loc *time.Location) (Rule, error) {
+	invalid := func(reason string) (Rule, error) {
+		return Rule{}, fmt.Errorf("%w: %q: %s", ErrInvalidRule, s, reason)
+	}
+
+	body := strings.TrimSpace(s)
+	if len(body) >= len("RRULE:") && strings.EqualFold(body[:len("RRULE:")], "RRULE:") {
+		body = body[len("RRULE:"):]
+	}
+
+	r := Rule{WeekStart: time.Monday}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(body, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if !ok || value == "" {
+			return invalid(fmt.Sprintf("bad part %q", part))
+		}
+		if seen[key] {
+			return invalid(fmt.Sprintf("%s given twice", key))
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			for f, name := range frequencyNames {
+				if name != "" && name == value {
+					r.Freq = Frequency(f)
+				}
+			}
+			if r.Freq == 0 {
+				return invalid(fmt.Sprintf("unknown frequency %q", value))
+			}
+		case "INTERVAL", "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return invalid(fmt.Sprintf("bad %s %q", key, value))
+			}
+			if key == "INTERVAL" {
+				r.Interval = n
+			} else {
+				r.Count = n
+			}
+		case "UNTIL":
+			t, dateOnly, ok := parseICalTime(value, loc)
+			if !ok {
+				return invalid(fmt.Sprintf("bad UNTIL %q", value))
+			}
+			if dateOnly {
+				t = EoD(t)
+			}
+			r.Until = t
+		case "BYDAY":
+			for _, item := range strings.Split(value, ",") {
+				if len(item) < 2 {
+					return invalid(fmt.Sprintf("bad BYDAY %q", item))
+				}
+				day, ok := parseRuleWeekday(item[len(item)-2:])
+				if !ok {
+					return invalid(fmt.Sprintf("bad BYDAY %q", item))
+				}
+				w := WeekdayNum{Weekday: day}
+				if ordinal := item[:len(item)-2]; ordinal != "" {
+					n, err := strconv.Atoi(ordinal)
+					if err != nil || n == 0 || n < -53 || n > 53 {
+						return invalid(fmt.Sprintf("bad BYDAY %q", item))
+					}
+					w.N = n
+				}
+				r.ByDay = append(r.ByDay, w)
+			}
+		case "BYMONTHDAY":
+			days, ok := parseRuleInts(value, 31, true)
+			if !ok {
+				return invalid(fmt.Sprintf("bad BYMONTHDAY %q", value))
+			}
+			r.ByMonthDay = days
+		case "BYMONTH":
+			months, ok := parseRuleInts(value, 12, false)
+			if !ok {
+				return invalid(fmt.Sprintf("bad BYMONTH %q", value))
+			}
+			for _, m := range months {
+				r.ByMonth = append(r.ByMonth, time.Month(m))
+			}
+		case "BYSETPOS":
+			positions, ok := parseRuleInts(value, 366, true)
+			if !ok {
+				return invalid(fmt.Sprintf("bad BYSETPOS %q", value))
+			}
+			r.BySetPos = positions
+		case "WKST":
+			day, ok := parseRuleWeekday(value)
+			if !ok {
+				return invalid(fmt.Sprintf("bad WKST %q", value))
+			}
+			r.WeekStart = day
+		default:
+			return invalid(fmt.Sprintf("unsupported part %q", key))
+		}
+	}
+
+	if r.Freq == 0 {
+		return invalid("missing FREQ")
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return invalid("COUNT and UNTIL are mutually exclusive")
+	}
+	if r.Freq != FrequencyMonthly && r.Freq != FrequencyYearly {
+		for _, w := range r.ByDay {
+			if w.N != 0 {
+				return invalid("BYDAY ordinals need a monthly or yearly rule")
+			}
+		}
+	}
+	return r, nil
+}
+
+// parseRuleInts parses a comma separated list of non-zero integers up to limit,
+// allowing negative values when signed is true.
+func parseRuleInts(value string, limit int, signed bool) ([]int, bool) {
+	var list []int
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil || n == 0 || n > limit || n < -limit || (n < 0 && !signed) {
+			return nil, false
+		}
+		list = append(list, n)
+	}
+	return list, true
+}
+
+// String returns the RRULE value of the rule, such as
+// "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE". UNTIL is written in UTC, and WKST is
+// left out when it is Monday.
+func (r Rule) String() string {
+	parts := []string{"FREQ=" + r.Freq.String()}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	if len(r.ByMonth) > 0 {
+		list := make([]string, len(r.ByMonth))
+		for i, m := range r.ByMonth {
+			list[i] = strconv.Itoa(int(m))
+		}
+		parts = append(parts, "BYMONTH="+strings.Join(list, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinRuleInts(r.ByMonthDay))
+	}
+	if len(r.ByDay) > 0 {
+		list := make([]string, len(r.ByDay))
+		for i, w := range r.ByDay {
+			list[i] = w.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(list, ","))
+	}
+	if len(r.BySetPos) > 0 {
+		parts = append(parts, "BYSETPOS="+joinRuleInts(r.BySetPos))
+	}
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+ruleWeekdays[r.WeekStart])
+	}
+	return strings.Join(parts, ";")
+}
+
+// joinRuleInts joins integers with commas.
+func joinRuleInts(list []int) string {
+	s := make([]string, len(list))
+	for i, n := range list {
+		s[i] = strconv.Itoa(n)
+	}
+	return strings.Join(s, ",")
+}
+
+// MarshalText implements encoding.TextMarshaler using the RRULE value.
+func (r Rule) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseRule.
+func (r *Rule) UnmarshalText(data []byte) error {
+	parsed, err := ParseRule(string(data))
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+// matchesDate reports whether the rule selects the given date. The start
+// supplies the day, weekday and month of rules that don't list them.
+func (r Rule) matchesDate(d Date, start time.Time) bool {
+	if len(r.ByMonth) > 0 {
+		found := false
+		for _, m := range r.ByMonth {
+			if m == d.Month {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	dim := DaysInMonth(d.Year, int(d.Month))
+	if len(r.ByMonthDay) > 0 {
+		found := false
+		for _, n := range r.ByMonthDay {
+			if n == d.Day || n < 0 && dim+n+1 == d.Day {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(r.ByDay) > 0 {
+		found := false
+		for _, w := range r.ByDay {
+			if w.Weekday != d.Weekday() {
+				continue
+			}
+			if w.N == 0 {
+				found = true
+				break
+			}
+			pos, size := d.Day, dim
+			if r.Freq == FrequencyYearly && len(r.ByMonth) == 0 {
+				pos, size = d.YearDay(), DaysInYear(d.Year)
+			}
+			if w.N > 0 && (pos-1)/7+1 == w.N || w.N < 0 && -((size-pos)/7+1) == w.N {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	// Without the BY parts that expand a period, the start picks the day.
+	noDays := len(r.ByDay) == 0 && len(r.ByMonthDay) == 0
+	switch r.Freq {
+	case FrequencyWeekly:
+		return len(r.ByDay) > 0 || d.Weekday() == start.Weekday()
+	case FrequencyMonthly:
+		return !noDays || d.Day == start.Day()
+	case FrequencyYearly:
+		return !noDays || d.Day == start.Day() && (len(r.ByMonth) > 0 || d.Month == start.Month())
+	}
+	return true
+}
+
+// periodDates returns the dates the rule selects in its kth period after the
+// start, for daily and longer frequencies.
+func (r Rule) periodDates(k, interval int, start time.Time) []Date {
+	s := DateOf(start)
+	n := k * interval
+
+	var first Date
+	var length int
+	switch r.Freq {
+	case FrequencyDaily:
+		first, length = s.AddDays(n), 1
+	case FrequencyWeekly:
+		first, length = DateOf(PrevOrSameWeekday(r.WeekStart, start)).AddDays(7*n), 7
+	case FrequencyMonthly:
+		first = Date{Year: s.Year, Month: s.Month, Day: 1}.AddMonths(n)
+		length = DaysInMonth(first.Year, int(first.Month))
+	case FrequencyYearly:
+		first = Date{Year: s.Year + n, Month: time.January, Day: 1}
+		length = DaysInYear(first.Year)
+	}
+
+	var dates []Date
+	for i := 0; i < length; i++ {
+		if d := first.AddDays(i); r.matchesDate(d, start) {
+			dates = append(dates, d)
+		}
+	}
+	return dates
+}
+
+// applySetPos keeps the occurrences of a period at the BYSETPOS positions.
+func (r Rule) applySetPos(times []time.Time) []time.Time {
+	if len(r.BySetPos) == 0 {
+		return times
+	}
+	var kept []time.Time
+	for i, t := range times {
+		for _, pos := range r.BySetPos {
+			if pos == i+1 || pos == i-len(times) {
+				kept = append(kept, t)
+				break
+			}
+		}
+	}
+	return kept
+}
+
+// Recurrence is an iCalendar recurrence set: a start time, an optional rule and
+// extra (RDATE) and excluded (EXDATE) times.
+//
+// The start is always the first occurrence, and the rule adds occurrences after
+// it at the same wall clock time in the start's location. As RFC 5545
+// requires, a time skipped by a DST transition moves forward by the length of
+// the gap and an ambiguous time resolves to its first instant.
+type Recurrence struct {
+	Start time.Time
+
+	// Rule is the RRULE. A rule with a zero Freq adds no occurrences.
+	Rule Rule
+
+	// RDates are extra occurrences and ExDates are excluded ones.
+	RDates  []time.Time
+	ExDates []time.Time
+}
+
+// ParseRecurrence parses an iCalendar recurrence set made of DTSTART, RRULE,
+// RDATE and EXDATE lines, such as:
+//
+//	DTSTART;TZID=America/New_York:20250701T090000
+//	RRULE:FREQ=WEEKLY;BYDAY=MO,WE
+//	EXDATE;TZID=America/New_York:20250707T090000
+//
+// DTSTART is required. Times may be in UTC ("Z"), in a TZID zone or floating;
+// a floating DTSTART is read in the local zone, and floating RDATE, EXDATE and
+// UNTIL values in the zone of DTSTART. RDATE and EXDATE accept comma separated
+// lists and may be repeated.
+func ParseRecurrence(s string) (Recurrence, error) {
+	invalid := func(reason string) (Recurrence, error) {
+		return Recurrence{}, fmt.Errorf("%w: %s", ErrInvalidRule, reason)
+	}
+
+	type property struct {
+		name, tzid, value string
+	}
+	var props []property
+	for _, line := range strings.FieldsFunc(s, func(r rune) bool { return r == '\n' || r == '\r' }) {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		head, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return invalid(fmt.Sprintf("bad line %q", line))
+		}
+		params := strings.Split(head, ";")
+		p := property{name: strings.ToUpper(params[0]), value: value}
+		for _, param := range params[1:] {
+			if k, v, ok := strings.Cut(param, "="); ok && strings.EqualFold(k, "TZID") {
+				p.tzid = strings.Trim(v, `"`)
+			}
+		}
+		props = append(props, p)
+	}
+
+	location := func(p property, floating *time.Location) (*time.Location, bool) {
+		if p.tzid == "" {
+			return floating, true
+		}
+		loc, err := LoadLocation(p.tzid)
+		return loc, err == nil
+	}
+
+	var rec Recurrence
+	hasStart := false
+	for _, p := range props {
+		if p.name != "DTSTART" {
+			continue
+		}
+		if hasStart {
+			return invalid("DTSTART given twice")
+		}
+		loc, ok := location(p, time.Local)
+		if !ok {
+			return invalid(fmt.Sprintf("unknown TZID %q", p.tzid))
+		}
+		t, _, ok := parseICalTime(p.value, loc)
+		if !ok {
+			return invalid(fmt.Sprintf("bad DTSTART %q", p.value))
+		}
+		rec.Start, hasStart = t, true
+	}
+	if !hasStart {
+		return invalid("missing DTSTART")
+	}
+
+	hasRule := false
+	for _, p := range props {
+		switch p.name {
+		case "DTSTART":
+		case "RRULE":
+			if hasRule {
+				return invalid("more than one RRULE")
+			}
+			r, err := parseRule(p.value, rec.Start.Location())
+			if err != nil {
+				return Recurrence{}, err
+			}
+			rec.Rule, hasRule = r, true
+		case "RDATE", "EXDATE":
+			loc, ok := location(p, rec.Start.Location())
+			if !ok {
+				return invalid(fmt.Sprintf("unknown TZID %q", p.tzid))
+			}
+			for _, v := range strings.Split(p.value, ",") {
+				t, _, ok := parseICalTime(v, loc)
+				if !ok {
+					return invalid(fmt.Sprintf("bad %s %q", p.name, v))
+				}
+				if p.name == "RDATE" {
+					rec.RDates = append(rec.RDates, t)
+				} else {
+					rec.ExDates = append(rec.ExDates, t)
+				}
+			}
+		default:
+			return invalid(fmt.Sprintf("unsupported property %q", p.name))
+		}
+	}
+	return rec, nil
+}
+
+// parseICalTime parses an iCalendar DATE or DATE-TIME value. Values without a
+// "Z" suffix are read in loc. It reports whether the value was a date.
+func parseICalTime(value string, loc *time.Location) (t time.Time, dateOnly, ok bool) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	switch {
+	case len(value) == len("20060102"):
+		t, err := time.ParseInLocation("20060102", value, loc)
+		return t, true, err == nil
+	case len(value) == len("20060102T150405Z") && strings.HasSuffix(value, "Z"):
+		t, err := time.Parse("20060102T150405Z", value)
+		return t, false, err == nil
+	case len(value) == len("20060102T150405"):
+		w, err := time.Parse("20060102T150405", value)
+		if err != nil {
+			return time.Time{}, false, false
+		}
+		t, _ = DateInLocation(loc, w.Year(), int(w.Month()), w.Day(), w.Hour(), w.Minute(), w.Second(), 0, DSTCompatible)
+		return t, false, true
+	}
+	return time.Time{}, false, false
+}
+
+// formatICalProperty writes an iCalendar property with a DATE-TIME value, in
+// UTC, floating for the local zone, or with a TZID.
+func formatICalProperty(name string, t time.Time) string {
+	switch t.Location() {
+	case time.UTC:
+		return name + ":" + t.Format("20060102T150405Z")
+	case time.Local:
+		return name + ":" + t.Format("20060102T150405")
+	}
+	return name + ";TZID=" + t.Location().String() + ":" + t.Format("20060102T150405")
+}
+
+// String returns the recurrence set as iCalendar lines separated by newlines:
+// DTSTART, RRULE when there is a rule, then one line per RDATE and EXDATE.
+func (rec Recurrence) String() string {
+	lines := []string{formatICalProperty("DTSTART", rec.Start)}
+	if rec.Rule.Freq != 0 {
+		lines = append(lines, "RRULE:"+rec.Rule.String())
+	}
+	for _, t := range rec.RDates {
+		lines = append(lines, formatICalProperty("RDATE", t))
+	}
+	for _, t := range rec.ExDates {
+		lines = append(lines, formatICalProperty("EXDATE", t))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Iterator returns an iterator over the occurrences of the recurrence, in
+// order. Occurrences are computed lazily, one period of the rule at a time.
+// A rule with an unknown frequency yields no occurrences beyond Start and the
+// RDATEs.
+func (rec Recurrence) Iterator() *RecurrenceIterator {
+	it := &RecurrenceIterator{
+		rec:      rec,
+		interval: rec.Rule.Interval,
+		pending:  []time.Time{rec.Start},
+		ruleDone: rec.Rule.Freq < FrequencySecondly || rec.Rule.Freq > FrequencyYearly,
+		exdates:  map[instantKey]bool{},
+	}
+	if it.interval < 1 {
+		it.interval = 1
+	}
+	if until := rec.Rule.Until; !until.IsZero() && rec.Start.After(until) {
+		it.pending, it.ruleDone = nil, true
+	}
+	it.rdates = append([]time.Time(nil), rec.RDates...)
+	sort.Slice(it.rdates, func(i, j int) bool { return it.rdates[i].Before(it.rdates[j]) })
+	for _, t := range rec.ExDates {
+		it.exdates[keyOf(t)] = true
+	}
+	return it
+}
+
+// Between returns the occurrences from start to end (inclusive). If end is
+// before start, the dates are swapped.
+//
+// Example:
+//
+//	rule, _ := gotime.ParseRule("FREQ=WEEKLY;BYDAY=MO,WE")
+//	rec := gotime.Recurrence{Start: time.Date(2025, 7, 7, 9, 0, 0, 0, time.UTC), Rule: rule}
+//	rec.Between(time.Date(2025, 7, 8, 0, 0, 0, 0, time.UTC), time.Date(2025, 7, 15, 0, 0, 0, 0, time.UTC))
+//	// [2025-07-09 09:00:00 2025-07-14 09:00:00]
+func (rec Recurrence) Between(start, end time.Time) []time.Time {
+	if end.Before(start) {
+		start, end = end, start
+	}
+	var list []time.Time
+	it := rec.Iterator()
+	for t, ok := it.Next(); ok && !t.After(end); t, ok = it.Next() {
+		if !t.Before(start) {
+			list = append(list, t)
+		}
+	}
+	return list
+}
+
+// After returns the first occurrence strictly after t, and false when there is
+// none.
+func (rec Recurrence) After(t time.Time) (time.Time, bool) {
+	it := rec.Iterator()
+	for next, ok := it.Next(); ok; next, ok = it.Next() {
+		if next.After(t) {
+			return next, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// maxEmptyPeriods is the number of consecutive periods without an occurrence
+// after which a rule is taken to have no more occurrences.
+const maxEmptyPeriods = 10000
+
+// instantKey identifies an instant regardless of its location.
+type instantKey struct {
+	sec  int64
+	nsec int
+}
+
+// keyOf returns the instantKey of t.
+func keyOf(t time.Time) instantKey {
+	return instantKey{t.Unix(), t.Nanosecond()}
+}
+
+// RecurrenceIterator walks the occurrences of a Recurrence in order. Create one
+// with Recurrence.Iterator.
+type RecurrenceIterator struct {
+	rec      Recurrence
+	interval int
+
+	// period is the index of the next period of the rule to expand, pending
+	// holds the rule occurrences expanded but not returned yet and emitted
+	// counts the rule occurrences returned, for COUNT.
+	period   int
+	pending  []time.Time
+	emitted  int
+	empty    int
+	ruleDone bool
+
+	rdates  []time.Time
+	exdates map[instantKey]bool
+
+	last    time.Time
+	started bool
+}
+
+// Next returns the next occurrence, and false when there are no more.
+func (it *RecurrenceIterator) Next() (time.Time, bool) {
+	for {
+		ruleNext, hasRule := it.peekRule()
+		hasRDate := len(it.rdates) > 0
+		if !hasRule && !hasRDate {
+			return time.Time{}, false
+		}
+
+		var t time.Time
+		if hasRule && (!hasRDate || !it.rdates[0].Before(ruleNext)) {
+			t = ruleNext
+			it.popRule()
+		} else {
+			t = it.rdates[0]
+			it.rdates = it.rdates[1:]
+		}
+
+		if it.started && t.Equal(it.last) {
+			continue
+		}
+		it.last, it.started = t, true
+		if it.exdates[keyOf(t)] {
+			continue
+		}
+		return t, true
+	}
+}
+
+// peekRule returns the next occurrence of the rule without consuming it.
+func (it *RecurrenceIterator) peekRule() (time.Time, bool) {
+	for len(it.pending) == 0 && !it.ruleDone {
+		it.expand()
+	}
+	if len(it.pending) == 0 {
+		return time.Time{}, false
+	}
+	return it.pending[0], true
+}
+
+// popRule consumes the next occurrence of the rule.
+func (it *RecurrenceIterator) popRule() {
+	it.pending = it.pending[1:]
+	it.emitted++
+	if count := it.rec.Rule.Count; count > 0 && it.emitted >= count {
+		it.pending, it.ruleDone = nil, true
+	}
+}
+
+// expand adds the occurrences of the next period of the rule to pending.
+func (it *RecurrenceIterator) expand() {
+	r, start := it.rec.Rule, it.rec.Start
+	k := it.period
+	it.period++
+
+	var times []time.Time
+	if r.Freq < FrequencyDaily {
+		step := time.Duration(it.interval) * frequencySteps[r.Freq]
+		t := start.Add(time.Duration(k) * step)
+		if r.matchesDate(DateOf(t), start) {
+			times = []time.Time{t}
+		} else {
+			// Skip to the first step on the next day.
+			next := SoD(Days(1, t)).Sub(start)
+			it.period = int((next + step - 1) / step)
+		}
+	} else {
+		for _, d := range r.periodDates(k, it.interval, start) {
+			t, _ := DateInLocation(start.Location(), d.Year, int(d.Month), d.Day,
+				start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), DSTCompatible)
+			times = append(times, t)
+		}
+	}
+	times = r.applySetPos(times)
+
+	if len(times) == 0 {
+		it.empty++
+		if it.empty >= maxEmptyPeriods {
+			it.ruleDone = true
+		}
+		return
+	}
+	it.empty = 0
+
+	for _, t := range times {
+		if !t.After(start) {
+			continue
+		}
+		if !r.Until.IsZero() && t.After(r.Until) {
+			it.ruleDone = true
+			break
+		}
+		it.pending = append(it.pending, t)
+	}
+	if t := times[len(times)-1]; t.Year() > 9999 {
+		it.ruleDone = true
+	}
+}
@@ -0,0 +1,314 @@
+package gotime_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+// take returns the first n occurrences of rec.
+func take(rec gotime.Recurrence, n int) []time.Time {
+	var list []time.Time
+	it := rec.Iterator()
+	for t, ok := it.Next(); ok && len(list) < n; t, ok = it.Next() {
+		list = append(list, t)
+	}
+	return list
+}
+
+func TestRecurrenceRFC5545Examples(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	at := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 9, 0, 0, 0, ny) }
+
+	tests := []struct {
+		name  string
+		start time.Time
+		rule  string
+		n     int
+		want  []time.Time
+	}{
+		{
+			"Daily for 10 occurrences", at(1997, 9, 2), "FREQ=DAILY;COUNT=10", 20,
+			[]time.Time{at(1997, 9, 2), at(1997, 9, 3), at(1997, 9, 4), at(1997, 9, 5), at(1997, 9, 6),
+				at(1997, 9, 7), at(1997, 9, 8), at(1997, 9, 9), at(1997, 9, 10), at(1997, 9, 11)},
+		},
+		{
+			"Every other day", at(1997, 9, 2), "FREQ=DAILY;INTERVAL=2", 4,
+			[]time.Time{at(1997, 9, 2), at(1997, 9, 4), at(1997, 9, 6), at(1997, 9, 8)},
+		},
+		{
+			"Weekly with WKST=MO", at(1997, 8, 5), "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=MO", 10,
+			[]time.Time{at(1997, 8, 5), at(1997, 8, 10), at(1997, 8, 19), at(1997, 8, 24)},
+		},
+		{
+			"Weekly with WKST=SU", at(1997, 8, 5), "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU", 10,
+			[]time.Time{at(1997, 8, 5), at(1997, 8, 17), at(1997, 8, 19), at(1997, 8, 31)},
+		},
+		{
+			"First Friday", at(1997, 9, 5), "FREQ=MONTHLY;COUNT=10;BYDAY=1FR", 20,
+			[]time.Time{at(1997, 9, 5), at(1997, 10, 3), at(1997, 11, 7), at(1997, 12, 5), at(1998, 1, 2),
+				at(1998, 2, 6), at(1998, 3, 6), at(1998, 4, 3), at(1998, 5, 1), at(1998, 6, 5)},
+		},
+		{
+			"Third to last day", at(1997, 9, 28), "FREQ=MONTHLY;BYMONTHDAY=-3", 6,
+			[]time.Time{at(1997, 9, 28), at(1997, 10, 29), at(1997, 11, 28), at(1997, 12, 29), at(1998, 1, 29), at(1998, 2, 26)},
+		},
+		{
+			"Last work day of the month", at(1997, 9, 29), "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", 6,
+			[]time.Time{at(1997, 9, 29), at(1997, 9, 30), at(1997, 10, 31), at(1997, 11, 28), at(1997, 12, 31), at(1998, 1, 30)},
+		},
+		{
+			"June and July", at(1997, 6, 10), "FREQ=YEARLY;COUNT=10;BYMONTH=6,7", 20,
+			[]time.Time{at(1997, 6, 10), at(1997, 7, 10), at(1998, 6, 10), at(1998, 7, 10), at(1999, 6, 10),
+				at(1999, 7, 10), at(2000, 6, 10), at(2000, 7, 10), at(2001, 6, 10), at(2001, 7, 10)},
+		},
+		{
+			"20th Monday of the year", at(1997, 5, 19), "FREQ=YEARLY;BYDAY=20MO", 3,
+			[]time.Time{at(1997, 5, 19), at(1998, 5, 18), at(1999, 5, 17)},
+		},
+		{
+			"US presidential election day", at(1996, 11, 5), "FREQ=YEARLY;INTERVAL=4;BYMONTH=11;BYDAY=TU;BYMONTHDAY=2,3,4,5,6,7,8", 3,
+			[]time.Time{at(1996, 11, 5), at(2000, 11, 7), at(2004, 11, 2)},
+		},
+		{
+			"Friday the 13th", at(1997, 9, 2), "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13", 5,
+			[]time.Time{at(1997, 9, 2), at(1998, 2, 13), at(1998, 3, 13), at(1998, 11, 13), at(1999, 8, 13)},
+		},
+		{
+			"Skips short months", at(2025, 1, 31), "FREQ=MONTHLY;COUNT=4", 10,
+			[]time.Time{at(2025, 1, 31), at(2025, 3, 31), at(2025, 5, 31), at(2025, 7, 31)},
+		},
+		{
+			"Leap day", at(2024, 2, 29), "FREQ=YEARLY;COUNT=3", 10,
+			[]time.Time{at(2024, 2, 29), at(2028, 2, 29), at(2032, 2, 29)},
+		},
+		{
+			"Until is inclusive", at(1997, 9, 2), "FREQ=WEEKLY;UNTIL=19970916T130000Z", 10,
+			[]time.Time{at(1997, 9, 2), at(1997, 9, 9), at(1997, 9, 16)},
+		},
+		{
+			"Hourly on weekends only", time.Date(2025, 7, 4, 22, 0, 0, 0, ny), "FREQ=HOURLY;INTERVAL=12;BYDAY=SA,SU", 4,
+			[]time.Time{time.Date(2025, 7, 4, 22, 0, 0, 0, ny), time.Date(2025, 7, 5, 10, 0, 0, 0, ny),
+				time.Date(2025, 7, 5, 22, 0, 0, 0, ny), time.Date(2025, 7, 6, 10, 0, 0, 0, ny)},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := gotime.ParseRule(tc.rule)
+			utils.AssertEqual(t, nil, err)
+			got := take(gotime.Recurrence{Start: tc.start, Rule: rule}, tc.n)
+			utils.AssertEqual(t, len(tc.want), len(got))
+			for i := range tc.want {
+				utils.AssertEqual(t, tc.want[i], got[i])
+			}
+		})
+	}
+}
+
+func TestRecurrenceEveryOtherWeekUntil(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	rec, err := gotime.ParseRecurrence("DTSTART;TZID=America/New_York:19970901T090000\n" +
+		"RRULE:FREQ=WEEKLY;INTERVAL=2;UNTIL=19971224T000000Z;WKST=SU;BYDAY=MO,WE,FR")
+	utils.AssertEqual(t, nil, err)
+
+	got := take(rec, 100)
+	utils.AssertEqual(t, 25, len(got))
+	utils.AssertEqual(t, time.Date(1997, 9, 1, 9, 0, 0, 0, ny), got[0])
+	utils.AssertEqual(t, time.Date(1997, 9, 15, 9, 0, 0, 0, ny), got[3])
+	utils.AssertEqual(t, time.Date(1997, 12, 22, 9, 0, 0, 0, ny), got[24])
+}
+
+func TestRecurrenceDST(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	rule, _ := gotime.ParseRule("FREQ=DAILY;COUNT=3")
+
+	// 02:30 doesn't exist on 2025-03-09 and moves forward by the gap.
+	got := take(gotime.Recurrence{Start: time.Date(2025, 3, 8, 2, 30, 0, 0, ny), Rule: rule}, 5)
+	utils.AssertEqual(t, []time.Time{
+		time.Date(2025, 3, 8, 2, 30, 0, 0, ny),
+		time.Date(2025, 3, 9, 3, 30, 0, 0, ny),
+		time.Date(2025, 3, 10, 2, 30, 0, 0, ny),
+	}, got)
+
+	// Weekly meetings keep their wall clock time across the transition.
+	rule, _ = gotime.ParseRule("FREQ=WEEKLY;COUNT=3")
+	got = take(gotime.Recurrence{Start: time.Date(2025, 3, 3, 9, 0, 0, 0, ny), Rule: rule}, 5)
+	utils.AssertEqual(t, 9, got[1].Hour())
+	utils.AssertEqual(t, 9, got[2].Hour())
+	utils.AssertEqual(t, 7*24*time.Hour-time.Hour, got[1].Sub(got[0]))
+}
+
+func TestRecurrenceRDateExDate(t *testing.T) {
+	start := time.Date(2025, 7, 7, 9, 0, 0, 0, time.UTC) // Monday
+	rule, _ := gotime.ParseRule("FREQ=WEEKLY;COUNT=4")
+	rec := gotime.Recurrence{
+		Start:   start,
+		Rule:    rule,
+		RDates:  []time.Time{time.Date(2025, 7, 16, 14, 0, 0, 0, time.UTC), time.Date(2025, 7, 14, 9, 0, 0, 0, time.UTC)},
+		ExDates: []time.Time{time.Date(2025, 7, 21, 9, 0, 0, 0, time.UTC)},
+	}
+
+	utils.AssertEqual(t, []time.Time{
+		start,
+		time.Date(2025, 7, 14, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 7, 16, 14, 0, 0, 0, time.UTC),
+		time.Date(2025, 7, 28, 9, 0, 0, 0, time.UTC),
+	}, take(rec, 10))
+
+	utils.AssertEqual(t, []time.Time{
+		time.Date(2025, 7, 14, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 7, 16, 14, 0, 0, 0, time.UTC),
+	}, rec.Between(time.Date(2025, 7, 20, 0, 0, 0, 0, time.UTC), time.Date(2025, 7, 8, 0, 0, 0, 0, time.UTC)))
+
+	next, ok := rec.After(time.Date(2025, 7, 16, 14, 0, 0, 0, time.UTC))
+	utils.AssertEqual(t, true, ok)
+	utils.AssertEqual(t, time.Date(2025, 7, 28, 9, 0, 0, 0, time.UTC), next)
+
+	_, ok = rec.After(time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC))
+	utils.AssertEqual(t, false, ok)
+
+	// Without a rule only the start and the extra dates occur.
+	only := gotime.Recurrence{Start: start, RDates: rec.RDates}
+	utils.AssertEqual(t, 3, len(take(only, 10)))
+}
+
+func TestRecurrenceImpossibleRuleEnds(t *testing.T) {
+	rule, _ := gotime.ParseRule("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30")
+	got := take(gotime.Recurrence{Start: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Rule: rule}, 10)
+	utils.AssertEqual(t, 1, len(got))
+}
+
+func TestRecurrenceInvalidFrequencyEnds(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, freq := range []gotime.Frequency{-1, gotime.FrequencyYearly + 1} {
+		got := take(gotime.Recurrence{Start: start, Rule: gotime.Rule{Freq: freq, Count: 3}}, 10)
+		utils.AssertEqual(t, 1, len(got))
+	}
+}
+
+func TestParseRule(t *testing.T) {
+	r, err := gotime.ParseRule("rrule:freq=monthly;byday=-1fr,2MO;count=6;bysetpos=1,-1")
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, gotime.Rule{
+		Freq:      gotime.FrequencyMonthly,
+		Count:     6,
+		ByDay:     []gotime.WeekdayNum{{N: -1, Weekday: time.Friday}, {N: 2, Weekday: time.Monday}},
+		BySetPos:  []int{1, -1},
+		WeekStart: time.Monday,
+	}, r)
+
+	r, err = gotime.ParseRule("FREQ=DAILY;UNTIL=20251231")
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, time.Date(2025, 12, 31, 23, 59, 59, 999999999, time.UTC), r.Until)
+
+	for _, s := range []string{
+		"FREQ=YEARLY;INTERVAL=2;BYMONTH=1,7;BYDAY=SU;BYSETPOS=1",
+		"FREQ=WEEKLY;INTERVAL=2;COUNT=8;BYDAY=TU,TH;WKST=SU",
+		"FREQ=MONTHLY;UNTIL=20251231T235959Z;BYMONTHDAY=1,-1",
+		"FREQ=HOURLY;INTERVAL=6",
+	} {
+		t.Run(s, func(t *testing.T) {
+			r, err := gotime.ParseRule(s)
+			utils.AssertEqual(t, nil, err)
+			utils.AssertEqual(t, s, r.String())
+
+			text, _ := r.MarshalText()
+			var back gotime.Rule
+			utils.AssertEqual(t, nil, back.UnmarshalText(text))
+			utils.AssertEqual(t, r, back)
+		})
+	}
+}
+
+func TestParseRuleInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"COUNT=3",
+		"FREQ=FORTNIGHTLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=-1",
+		"FREQ=DAILY;COUNT=3;UNTIL=20250101T000000Z",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=MONTHLY;BYDAY=XX",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=YEARLY;BYMONTH=0",
+		"FREQ=YEARLY;BYMONTH=-1",
+		"FREQ=DAILY;BYHOUR=9",
+		"FREQ=DAILY;UNTIL=2025",
+		"FREQ=DAILY;WKST=XX",
+		"FREQ",
+	} {
+		t.Run(s, func(t *testing.T) {
+			_, err := gotime.ParseRule(s)
+			utils.AssertEqual(t, true, errors.Is(err, gotime.ErrInvalidRule))
+		})
+	}
+}
+
+func TestParseRecurrence(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	text := "DTSTART;TZID=America/New_York:20250707T090000\n" +
+		"RRULE:FREQ=WEEKLY;UNTIL=20250728T130000Z;BYDAY=MO,WE\n" +
+		"RDATE:20250712T150000Z\n" +
+		"EXDATE;TZID=America/New_York:20250709T090000\n" +
+		"EXDATE:20250716T090000"
+
+	rec, err := gotime.ParseRecurrence(text)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, time.Date(2025, 7, 7, 9, 0, 0, 0, ny), rec.Start)
+	utils.AssertEqual(t, []time.Time{time.Date(2025, 7, 12, 15, 0, 0, 0, time.UTC)}, rec.RDates)
+	utils.AssertEqual(t, []time.Time{time.Date(2025, 7, 9, 9, 0, 0, 0, ny), time.Date(2025, 7, 16, 9, 0, 0, 0, ny)}, rec.ExDates)
+
+	got := take(rec, 20)
+	want := []time.Time{
+		time.Date(2025, 7, 7, 9, 0, 0, 0, ny),
+		time.Date(2025, 7, 12, 11, 0, 0, 0, ny),
+		time.Date(2025, 7, 14, 9, 0, 0, 0, ny),
+		time.Date(2025, 7, 21, 9, 0, 0, 0, ny),
+		time.Date(2025, 7, 23, 9, 0, 0, 0, ny),
+		time.Date(2025, 7, 28, 9, 0, 0, 0, ny),
+	}
+	utils.AssertEqual(t, len(want), len(got))
+	for i := range want {
+		utils.AssertEqual(t, want[i], got[i])
+	}
+
+	utils.AssertEqual(t, "DTSTART;TZID=America/New_York:20250707T090000\n"+
+		"RRULE:FREQ=WEEKLY;UNTIL=20250728T130000Z;BYDAY=MO,WE\n"+
+		"RDATE:20250712T150000Z\n"+
+		"EXDATE;TZID=America/New_York:20250709T090000\n"+
+		"EXDATE;TZID=America/New_York:20250716T090000", rec.String())
+
+	for _, bad := range []string{
+		"RRULE:FREQ=DAILY",
+		"DTSTART:2025",
+		"DTSTART;TZID=Mars/Olympus:20250707T090000",
+		"DTSTART:20250707T090000Z\nRRULE:FREQ=NEVER",
+		"DTSTART:20250707T090000Z\nRRULE:FREQ=DAILY\nRRULE:FREQ=WEEKLY",
+		"DTSTART:20250707T090000Z\nSUMMARY:Standup",
+		"DTSTART:20250707T090000Z\nEXDATE:yesterday",
+	} {
+		_, err := gotime.ParseRecurrence(bad)
+		utils.AssertEqual(t, true, errors.Is(err, gotime.ErrInvalidRule))
+	}
+}
+
+func ExampleRecurrence() {
+	rule, _ := gotime.ParseRule("FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=4")
+	rec := gotime.Recurrence{Start: time.Date(2025, 1, 31, 17, 0, 0, 0, time.UTC), Rule: rule}
+
+	it := rec.Iterator()
+	for t, ok := it.Next(); ok; t, ok = it.Next() {
+		fmt.Println(t.Format("Mon 2006-01-02 15:04"))
+	}
+	// Output:
+	// Fri 2025-01-31 17:00
+	// Fri 2025-02-28 17:00
+	// Mon 2025-03-31 17:00
+	// Wed 2025-04-30 17:00
+}
@@ -15,15 +15,7 @@ import "time"
 //	start = gotime.YearStart(someDate)
 //	// start: 2024-01-01 00:00:00
 func YearStart(dt ...time.Time) time.Time {
-	var t time.Time
-	if len(dt) > 0 {
-		t = dt[0]
-	} else {
-		t = time.Now()
-
-	}
-	start := time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location())
-	return start
+	return PeriodStart(PeriodYear, dt...)
 }
 
 // YearEnd returns the last day and last second of the year for the given date.
@@ -38,15 +30,7 @@ func YearStart(dt ...time.Time) time.Time {
 //	end = gotime.YearEnd(someDate)
 //	// end: 2024-12-31 23:59:59.999999999
 func YearEnd(dt ...time.Time) time.Time {
-	var t time.Time
-	if len(dt) > 0 {
-		t = dt[0]
-	} else {
-		t = time.Now()
-
-	}
-	end := time.Date(t.Year(), 12, 31, 23, 59, 59, 999999999, t.Location())
-	return end
+	return PeriodEnd(PeriodYear, dt...)
 }
 
 // Years returns the date after adding the specified number of years to the given date.
@@ -62,16 +46,7 @@ func YearEnd(dt ...time.Time) time.Time {
 //	result := gotime.Years(5, someDate)
 //	// result: 2025-01-01
 func Years(years int, dt ...time.Time) time.Time {
-	var t time.Time
-	if len(dt) > 0 {
-		t = dt[0]
-	} else {
-		t = time.Now()
-	}
-	if years == 0 {
-		return t
-	}
-	return t.AddDate(years, 0, 0)
+	return AddPeriods(PeriodYear, years, dt...)
 }
 
 // LastYear returns the date one year ago from the current time.
@@ -81,7 +56,7 @@ func Years(years int, dt ...time.Time) time.Time {
 //	lastYear := gotime.LastYear()
 //	// lastYear: 2024-07-08 (if current time is 2025-07-08)
 func LastYear() time.Time {
-	return time.Now().AddDate(-1, 0, 0)
+	return Now().AddDate(-1, 0, 0)
 }
 
 // NextYear returns the date one year from the current time.
@@ -91,7 +66,7 @@ func LastYear() time.Time {
 //	nextYear := gotime.NextYear()
 //	// nextYear: 2026-07-08 (if current time is 2025-07-08)
 func NextYear() time.Time {
-	return time.Now().AddDate(1, 0, 0)
+	return Now().AddDate(1, 0, 0)
 }
 
 //-----------------Month Functions-----------------
@@ -108,14 +83,7 @@ func NextYear() time.Time {
 //	start = gotime.MonthStart(someDate)
 //	// start: 2024-06-01 00:00:00
 func MonthStart(dt ...time.Time) time.Time {
-	var t time.Time
-	if len(dt) > 0 {
-		t = dt[0]
-	} else {
-		t = time.Now()
-	}
-	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
-	return start
+	return PeriodStart(PeriodMonth, dt...)
 }
 
 // MonthEnd returns the last day and last second of the month for the given date.
@@ -130,14 +98,7 @@ func MonthStart(dt ...time.Time) time.Time {
 //	end = gotime.MonthEnd(someDate)
 //	// end: 2024-02-29 23:59:59.999999999 (leap year)
 func MonthEnd(dt ...time.Time) time.Time {
-	var t time.Time
-	if len(dt) > 0 {
-		t = dt[0]
-	} else {
-		t = time.Now()
-	}
-	end := time.Date(t.Year(), t.Month()+1, 0, 23, 59, 59, 999999999, t.Location())
-	return end
+	return PeriodEnd(PeriodMonth, dt...)
 }
 
 // LastMonth returns the date one month ago from the current time.
@@ -147,7 +108,7 @@ func MonthEnd(dt ...time.Time) time.Time {
 //	lastMonth := gotime.LastMonth()
 //	// lastMonth: 2025-06-08 (if current time is 2025-07-08)
 func LastMonth() time.Time {
-	return time.Now().AddDate(0, -1, 0)
+	return Now().AddDate(0, -1, 0)
 }
 
 // NextMonth returns the date one month from the current time.
@@ -157,7 +118,7 @@ func LastMonth() time.Time {
 //	nextMonth := gotime.NextMonth()
 //	// nextMonth: 2025-08-08 (if current time is 2025-07-08)
 func NextMonth() time.Time {
-	return time.Now().AddDate(0, 1, 0)
+	return Now().AddDate(0, 1, 0)
 }
 
 // Months returns the date after adding the specified number of months to the given date.
@@ -173,16 +134,7 @@ func NextMonth() time.Time {
 //	result := gotime.Months(1, someDate)
 //	// result: 2020-02-29 (handles month-end edge cases)
 func Months(months int, dt ...time.Time) time.Time {
-	var t time.Time
-	if len(dt) > 0 {
-		t = dt[0]
-	} else {
-		t = time.Now()
-	}
-	if months == 0 {
-		return t
-	}
-	return t.AddDate(0, months, 0)
+	return AddPeriods(PeriodMonth, months, dt...)
 }
 
 //-----------------Week Functions-----------------
@@ -199,14 +151,7 @@ func Months(months int, dt ...time.Time) time.Time {
 //	start = gotime.WeekStart(someDate)
 //	// start: 2025-07-06 00:00:00 (Sunday of that week)
 func WeekStart(dt ...time.Time) time.Time {
-	var t time.Time
-	if len(dt) > 0 {
-		t = dt[0]
-	} else {
-		t = time.Now()
-	}
-	start := t.AddDate(0, 0, -int(t.Weekday()))
-	return time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	return PeriodStart(PeriodWeek, dt...)
 }
 
 // WeekStartOn returns the first occurrence of the specified weekday for the given date's week.
@@ -225,7 +170,7 @@ func WeekStartOn(day time.Weekday, dt ...time.Time) time.Time {
 	if len(dt) > 0 {
 		t = dt[0]
 	} else {
-		t = time.Now()
+		t = Now()
 	}
 	start := t.AddDate(0, 0, -int(t.Weekday())+int(day))
 	return time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
@@ -241,14 +186,7 @@ func WeekStartOn(day time.Weekday, dt ...time.Time) time.Time {
 //
 // If the date is not provided, it will return the last day of the week from the current date.
 func WeekEnd(dt ...time.Time) time.Time {
-	var t time.Time
-	if len(dt) > 0 {
-		t = dt[0]
-	} else {
-		t = time.Now()
-	}
-	end := t.AddDate(0, 0, 6-int(t.Weekday()))
-	return time.Date(end.Year(), end.Month(), end.Day(), 23, 59, 59, 999999999, end.Location())
+	return PeriodEnd(PeriodWeek, dt...)
 }
 
 // WeekEndOn returns the last day and the last second of the week on the given day.
@@ -268,7 +206,7 @@ func WeekEndOn(day time.Weekday, dt ...time.Time) time.Time {
 	if len(dt) > 0 {
 		t = dt[0]
 	} else {
-		t = time.Now()
+		t = Now()
 	}
 	end := t.AddDate(0, 0, 6-int(t.Weekday())+int(day))
 	return time.Date(end.Year(), end.Month(), end.Day(), 23, 59, 59, 999999999, end.Location())
@@ -276,12 +214,12 @@ func WeekEndOn(day time.Weekday, dt ...time.Time) time.Time {
 
 // LastWeek returns the last week's time.Time corresponding to the current time.
 func LastWeek() time.Time {
-	return time.Now().AddDate(0, 0, -7)
+	return Now().AddDate(0, 0, -7)
 }
 
 // NextWeek returns the next week's time.Time corresponding to the current time.
 func NextWeek() time.Time {
-	return time.Now().AddDate(0, 0, 7)
+	return Now().AddDate(0, 0, 7)
 }
 
 // Weeks returns the date of the given number of weeks from the current date.
@@ -295,27 +233,18 @@ func NextWeek() time.Time {
 //
 // # Note
 //
-// If the weeks parameter is 0 it will panic.
+// If weeks is 0, it returns the original date unchanged.
 func Weeks(weeks int, dt ...time.Time) time.Time {
-	var t time.Time
-	if len(dt) > 0 {
-		t = dt[0]
-	} else {
-		t = time.Now()
-	}
-	if weeks == 0 {
-		return t
-	}
-	return t.AddDate(0, 0, weeks*7)
+	return AddPeriods(PeriodWeek, weeks, dt...)
 }
 
 //-----------------Day Functions-----------------
 
 // EoD returns the end of the day for the given time.
 //
-// It calculates the start of the day for the given time using the SoD function, and adds 24 hours to it.
-// To get the end of the day, it subtracts one nanosecond from the resulting time.Time value,
-// since the SoD function returns the first nanosecond of the day.
+// It calculates the start of the next day in the same location and subtracts one
+// nanosecond from it, so the result is the last instant of the day even when the
+// last hour repeats because of a DST transition.
 //
 // Example:
 //
@@ -323,18 +252,7 @@ func Weeks(weeks int, dt ...time.Time) time.Time {
 //	endOfDay := gotime.EoD(t)
 //	// endOfDay == time.Date(2022, time.December, 30, 23, 59, 59, 999999999, time.UTC)
 func EoD(t ...time.Time) time.Time {
-	var dt time.Time
-	if len(t) > 0 {
-		dt = t[0]
-	} else {
-		dt = time.Now()
-	}
-
-	return time.Date(
-		dt.Year(), dt.Month(), dt.Day(),
-		23, 59, 59, 999999999,
-		dt.Location(),
-	)
+	return PeriodEnd(PeriodDay, t...)
 }
 
 // SoD returns the start of the day for the given time.
@@ -342,7 +260,8 @@ func EoD(t ...time.Time) time.Time {
 // It constructs a new time.Time value using the year, month, and day of the given time.Time value,
 // and setting the hour, minute, second, and nanosecond fields to 0. It also sets the location field to the
 // same location as the input time.Time value. The resulting time.Time value represents the start of the day
-// for the given time.
+// for the given time. When midnight does not exist because of a DST transition, it returns the first
+// instant after the gap.
 //
 // Example:
 //
@@ -350,22 +269,17 @@ func EoD(t ...time.Time) time.Time {
 //	startOfDay := gotime.SoD(t)
 //	// startOfDay == time.Date(2022, time.December, 30, 0, 0, 0, 0, time.UTC)
 func SoD(t ...time.Time) time.Time {
-	if len(t) > 0 {
-		return time.Date(t[0].Year(), t[0].Month(), t[0].Day(), 0, 0, 0, 0, t[0].Location())
-	}
-
-	dt := time.Now()
-	return time.Date(dt.Year(), dt.Month(), dt.Day(), 0, 0, 0, 0, dt.Location())
+	return PeriodStart(PeriodDay, t...)
 }
 
 // Yesterday returns the yesterday's time.Time corresponding to the current time.
 func Yesterday() time.Time {
-	return time.Now().AddDate(0, 0, -1)
+	return Now().AddDate(0, 0, -1)
 }
 
 // Tomorrow returns the tomorrow's time.Time corresponding to the current time.
 func Tomorrow() time.Time {
-	return time.Now().AddDate(0, 0, 1)
+	return Now().AddDate(0, 0, 1)
 }
 
 // Days returns the date of the given number of days from the date provided,
@@ -378,16 +292,22 @@ func Tomorrow() time.Time {
 //
 // # Note
 //
-// If the days parameter is 0 it will panic.
+// If days is 0, it returns the original date unchanged.
 func Days(days int, dt ...time.Time) time.Time {
-	var t time.Time
-	if len(dt) > 0 {
-		t = dt[0]
-	} else {
-		t = time.Now()
-	}
-	if days == 0 {
-		return t
-	}
-	return t.AddDate(0, 0, days)
+	return AddPeriods(PeriodDay, days, dt...)
+}
+
+// DaysWithPolicy returns t moved by the given number of calendar days, keeping its
+// wall clock time. Unlike Days, which relies on time.Date normalization, a wall
+// clock time that is skipped or repeated on the target day is resolved with the
+// given policy. With DSTReject it returns ErrNonexistentTime or ErrAmbiguousTime.
+//
+// Example:
+//
+//	loc, _ := gotime.LoadLocation("America/New_York")
+//	t := time.Date(2025, 3, 8, 2, 30, 0, 0, loc)
+//	next, _ := gotime.DaysWithPolicy(1, t, gotime.DSTShiftForward)
+//	// next: 2025-03-09 03:00:00 -0400 EDT (02:30 does not exist that day)
+func DaysWithPolicy(days int, t time.Time, policy DSTPolicy) (time.Time, error) {
+	return DateInLocation(t.Location(), t.Year(), int(t.Month()), t.Day()+days, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), policy)
 }
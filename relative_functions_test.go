@@ -9,13 +9,15 @@ import (
 )
 
 func TestYear(t *testing.T) {
+	defer gotime.SetClock(gotime.NewFakeClock(time.Now()))()
+
 	// YearStart
 	expectedDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 	functionDate := gotime.YearStart(time.Date(2023, 1, 1, 11, 2, 10, 0, time.UTC))
 
 	utils.AssertEqual(t, expectedDate, functionDate)
 
-	now := time.Now()
+	now := gotime.Now()
 	expectedDate = time.Date(now.Year(), 1, 1, 0, 0, 0, 0, time.Local)
 	functionDate = gotime.YearStart()
 	utils.AssertEqual(t, expectedDate, functionDate)
@@ -31,13 +33,13 @@ func TestYear(t *testing.T) {
 	utils.AssertEqual(t, expectedDate, functionDate)
 
 	// LastYear
-	expectedDate = time.Now().AddDate(-1, 0, 0)
+	expectedDate = gotime.Now().AddDate(-1, 0, 0)
 	functionDate = gotime.LastYear()
 
 	utils.AssertEqual(t, expectedDate, functionDate)
 
 	// NextYear
-	expectedDate = time.Now().AddDate(1, 0, 0)
+	expectedDate = gotime.Now().AddDate(1, 0, 0)
 	functionDate = gotime.NextYear()
 
 	utils.AssertEqual(t, expectedDate, functionDate)
@@ -63,6 +65,8 @@ func TestYear(t *testing.T) {
 }
 
 func TestMonth(t *testing.T) {
+	defer gotime.SetClock(gotime.NewFakeClock(time.Now()))()
+
 	// MonthStart - write a test that loops through all the months and checks that the first day of the month is correct
 	months := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
 
@@ -73,7 +77,7 @@ func TestMonth(t *testing.T) {
 
 		utils.AssertEqual(t, expectedDate, functionDate)
 	}
-	now := time.Now()
+	now := gotime.Now()
 	expectedDate := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
 	functionDate := gotime.MonthStart()
 	utils.AssertEqual(t, expectedDate, functionDate)
@@ -90,13 +94,13 @@ func TestMonth(t *testing.T) {
 	utils.AssertEqual(t, expectedDate, functionDate)
 
 	// LastMonth
-	expectedDate = time.Now().AddDate(0, -1, 0)
+	expectedDate = gotime.Now().AddDate(0, -1, 0)
 	functionDate = gotime.LastMonth()
 
 	utils.AssertEqual(t, expectedDate, functionDate)
 
 	// NextMonth
-	expectedDate = time.Now().AddDate(0, 1, 0)
+	expectedDate = gotime.Now().AddDate(0, 1, 0)
 	functionDate = gotime.NextMonth()
 
 	utils.AssertEqual(t, expectedDate, functionDate)
@@ -127,13 +131,15 @@ func trunccateSecond(t time.Time) time.Time {
 }
 
 func TestWeek(t *testing.T) {
+	defer gotime.SetClock(gotime.NewFakeClock(time.Now()))()
+
 	// WeekStart
 	expectedDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 	functionDate := gotime.WeekStart(time.Date(2023, 1, 1, 11, 2, 10, 0, time.UTC))
 
 	utils.AssertEqual(t, expectedDate, functionDate)
 
-	now := time.Now()
+	now := gotime.Now()
 	expectedDate = trunccateSecond(time.Date(now.Year(), now.Month(), now.Day()-int(now.Weekday()), 0, 0, 0, 0, time.Local))
 	functionDate = trunccateSecond(gotime.WeekStart())
 	utils.AssertEqual(t, expectedDate, functionDate)
@@ -149,13 +155,13 @@ func TestWeek(t *testing.T) {
 	utils.AssertEqual(t, expectedDate, functionDate)
 
 	// LastWeek
-	expectedDate = time.Now().AddDate(0, 0, -7)
+	expectedDate = gotime.Now().AddDate(0, 0, -7)
 	functionDate = gotime.LastWeek()
 
 	utils.AssertEqual(t, expectedDate, functionDate)
 
 	// NextWeek
-	expectedDate = time.Now().AddDate(0, 0, 7)
+	expectedDate = gotime.Now().AddDate(0, 0, 7)
 	functionDate = gotime.NextWeek()
 
 	utils.AssertEqual(t, expectedDate, functionDate)
@@ -196,7 +202,7 @@ func TestWeek(t *testing.T) {
 
 	utils.AssertEqual(t, expectedDate, functionDate)
 
-	now = time.Now()
+	now = gotime.Now()
 
 	expectedDate = trunccateSecond(time.Date(now.Year(), now.Month(), now.Day()-int(now.Weekday())+1, 0, 0, 0, 0, time.Local))
 	functionDate = trunccateSecond(gotime.WeekStartOn(time.Monday))
@@ -214,7 +220,7 @@ func TestWeek(t *testing.T) {
 
 	utils.AssertEqual(t, expectedDate, functionDate)
 
-	now = time.Now()
+	now = gotime.Now()
 
 	expectedDate = time.Date(now.Year(), now.Month(), now.Day()-int(now.Weekday())+7, 23, 59, 59, 999999999, time.Local)
 	functionDate = gotime.WeekEndOn(time.Monday)
@@ -223,13 +229,15 @@ func TestWeek(t *testing.T) {
 }
 
 func TestDay(t *testing.T) {
+	defer gotime.SetClock(gotime.NewFakeClock(time.Now()))()
+
 	// SoD
 	expectedDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 	functionDate := gotime.SoD(time.Date(2023, 1, 1, 11, 2, 10, 0, time.UTC))
 
 	utils.AssertEqual(t, expectedDate, functionDate)
 
-	now := time.Now()
+	now := gotime.Now()
 	expectedDate = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
 	functionDate = gotime.SoD()
 	utils.AssertEqual(t, expectedDate, functionDate)
@@ -245,13 +253,13 @@ func TestDay(t *testing.T) {
 	utils.AssertEqual(t, expectedDate, functionDate)
 
 	// Yesterday
-	expectedDate = time.Now().AddDate(0, 0, -1)
+	expectedDate = gotime.Now().AddDate(0, 0, -1)
 	functionDate = gotime.Yesterday()
 
 	utils.AssertEqual(t, expectedDate, functionDate)
 
 	// Tomorrow
-	expectedDate = time.Now().AddDate(0, 0, 1)
+	expectedDate = gotime.Now().AddDate(0, 0, 1)
 	functionDate = gotime.Tomorrow()
 
 	utils.AssertEqual(t, expectedDate, functionDate)
@@ -277,3 +285,62 @@ func TestDay(t *testing.T) {
 	utils.AssertEqual(t, expectedDate, functionDate)
 
 }
+
+func TestDayBoundariesAcrossDST(t *testing.T) {
+	tests := []struct {
+		name  string
+		zone  string
+		date  [3]int
+		start time.Time // in UTC
+		end   time.Time // in UTC
+	}{
+		// Clocks jump from 00:00 to 01:00, so the day starts at 01:00
+		{"Santiago spring", "America/Santiago", [3]int{2024, 9, 8}, time.Date(2024, 9, 8, 4, 0, 0, 0, time.UTC), time.Date(2024, 9, 9, 2, 59, 59, 999999999, time.UTC)},
+		// Clocks fall back from 24:00 to 23:00, so the day ends after the second 23:59
+		{"Santiago autumn", "America/Santiago", [3]int{2025, 4, 5}, time.Date(2025, 4, 5, 3, 0, 0, 0, time.UTC), time.Date(2025, 4, 6, 3, 59, 59, 999999999, time.UTC)},
+		{"Havana spring", "America/Havana", [3]int{2025, 3, 9}, time.Date(2025, 3, 9, 5, 0, 0, 0, time.UTC), time.Date(2025, 3, 10, 3, 59, 59, 999999999, time.UTC)},
+		{"New York spring", "America/New_York", [3]int{2025, 3, 9}, time.Date(2025, 3, 9, 5, 0, 0, 0, time.UTC), time.Date(2025, 3, 10, 3, 59, 59, 999999999, time.UTC)},
+		{"London autumn", "Europe/London", [3]int{2025, 10, 26}, time.Date(2025, 10, 25, 23, 0, 0, 0, time.UTC), time.Date(2025, 10, 26, 23, 59, 59, 999999999, time.UTC)},
+		{"Lord Howe spring", "Australia/Lord_Howe", [3]int{2025, 10, 5}, time.Date(2025, 10, 4, 13, 30, 0, 0, time.UTC), time.Date(2025, 10, 5, 12, 59, 59, 999999999, time.UTC)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			loc := mustLoad(t, tc.zone)
+			noon := time.Date(tc.date[0], time.Month(tc.date[1]), tc.date[2], 12, 0, 0, 0, loc)
+
+			start := gotime.SoD(noon)
+			end := gotime.EoD(noon)
+			utils.AssertEqual(t, tc.start, start)
+			utils.AssertEqual(t, tc.end, end)
+			utils.AssertEqual(t, loc, start.Location())
+			utils.AssertEqual(t, tc.date[2], start.Day())
+			utils.AssertEqual(t, tc.date[2], end.Day())
+
+			// Month boundaries go through the same resolution
+			utils.AssertEqual(t, gotime.MonthStart(noon), gotime.SoD(gotime.MonthStart(noon)))
+		})
+	}
+}
+
+func TestDaysWithPolicy(t *testing.T) {
+	newYork := mustLoad(t, "America/New_York")
+	src := time.Date(2025, 3, 8, 2, 30, 0, 0, newYork)
+
+	next, err := gotime.DaysWithPolicy(1, src, gotime.DSTShiftForward)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, time.Date(2025, 3, 9, 3, 0, 0, 0, newYork), next)
+
+	next, err = gotime.DaysWithPolicy(1, src, gotime.DSTLatest)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, time.Date(2025, 3, 9, 3, 30, 0, 0, newYork), next)
+
+	_, err = gotime.DaysWithPolicy(1, src, gotime.DSTReject)
+	if err == nil {
+		t.Error("Expected error for nonexistent time")
+	}
+
+	// Regular days match Days
+	next, err = gotime.DaysWithPolicy(-3, src, gotime.DSTReject)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, gotime.Days(-3, src), next)
+}
@@ -0,0 +1,392 @@
+package gotime
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidRelative is returned when ParseRelative does not understand an
+// expression.
+var ErrInvalidRelative = errors.New("invalid relative date expression")
+
+// relativeWeekdays maps the weekday names and abbreviations accepted by
+// ParseRelative to their weekdays.
+var relativeWeekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// relativeMonths maps the month names and abbreviations accepted by
+// ParseRelative to their months.
+var relativeMonths = map[string]time.Month{
+	"january": time.January, "jan": time.January,
+	"february": time.February, "feb": time.February,
+	"march": time.March, "mar": time.March,
+	"april": time.April, "apr": time.April,
+	"may":  time.May,
+	"june": time.June, "jun": time.June,
+	"july": time.July, "jul": time.July,
+	"august": time.August, "aug": time.August,
+	"september": time.September, "sep": time.September, "sept": time.September,
+	"october": time.October, "oct": time.October,
+	"november": time.November, "nov": time.November,
+	"december": time.December, "dec": time.December,
+}
+
+// relativePeriods maps the period names accepted by ParseRelative to their units.
+var relativePeriods = map[string]PeriodUnit{
+	"day":     PeriodDay,
+	"week":    PeriodWeek,
+	"month":   PeriodMonth,
+	"quarter": PeriodQuarter,
+	"year":    PeriodYear,
+}
+
+// relativeOrdinals maps the ordinals accepted by ParseRelative to their
+// positions. Negative positions count from the end.
+var relativeOrdinals = map[string]int{
+	"first": 1, "1st": 1,
+	"second": 2, "2nd": 2,
+	"third": 3, "3rd": 3,
+	"fourth": 4, "4th": 4,
+	"fifth": 5, "5th": 5,
+	"last": -1,
+}
+
+// relativeShifts maps the words selecting the current, next or previous period
+// to their offsets.
+var relativeShifts = map[string]int{"this": 0, "next": 1, "last": -1, "previous": -1}
+
+// mondayToFriday are the working days used for business day offsets.
+var mondayToFriday = [7]bool{false, true, true, true, true, true, false}
+
+// ParseRelative parses a natural-language date expression relative to the base
+// time, such as "next friday", "tomorrow 9am", "in 3 days", "2 weeks ago",
+// "last day of next month", "end of quarter" or "first monday of june". If no
+// base time is provided, it uses the current time. Matching is case
+// insensitive, and the word "the" is ignored.
+//
+// The following expressions are understood:
+//
+//   - now, today, tomorrow, yesterday, day after tomorrow, day before yesterday
+//   - in <duration>, <duration> ago, <duration> from now, <duration> later,
+//     where the duration is anything ParseHumanPeriod accepts ("3 days",
+//     "an hour", "1 month and 2 days"). Business days count Monday to Friday.
+//   - <weekday>, this <weekday>, next <weekday>, last <weekday>
+//   - this/next/last week, month, quarter or year
+//   - start of <period>, beginning of <period>, end of <period>
+//   - first day of <period>, last day of <period>
+//   - <ordinal> <weekday> of <period>, with the ordinals first to fifth (or
+//     1st to 5th) and last
+//
+// A period is day, week, month, quarter or year, optionally preceded by this,
+// next, last or previous, or a month name optionally followed by a year, as in
+// "june" or "june 2026". A month name without a year is in the base year.
+//
+// A weekday on its own is the next such day, or today if today is that day;
+// "this <weekday>" is the day in the current Sunday to Saturday week, "next" is
+// the first such day after today and "last" the most recent such day before
+// today. Weeks start on Sunday, matching WeekStart.
+//
+// Offsets keep the clock time of the base time, "end of" expressions return
+// the last nanosecond of the period like MonthEnd and every other expression
+// returns the start of the day. A time of day may be added before or after the
+// expression, as in "tomorrow 9am", "friday at 17:30" or "noon tomorrow"; a time
+// on its own is today. Accepted times are "9am", "9:30 pm", "17:30", "17:30:15",
+// "noon" and "midnight", and after "at" also a bare hour ("at 9"). A time that
+// falls in a DST gap moves forward by the length of the gap.
+//
+// Example:
+//
+//	base := time.Date(2025, 7, 9, 14, 30, 0, 0, time.UTC) // Wednesday
+//	t, err := gotime.ParseRelative("next friday", base)
+//	// t: 2025-07-11 00:00:00
+//	t, err = gotime.ParseRelative("tomorrow 9am", base)
+//	// t: 2025-07-10 09:00:00
+//	t, err = gotime.ParseRelative("last day of next month", base)
+//	// t: 2025-08-31 00:00:00
+//	t, err = gotime.ParseRelative("first monday of june", base)
+//	// t: 2025-06-02 00:00:00
+func ParseRelative(expr string, base ...time.Time) (time.Time, error) {
+	var now time.Time
+	if len(base) > 0 {
+		now = base[0]
+	} else {
+		now = Now()
+	}
+
+	invalid := func(reason string) (time.Time, error) {
+		return time.Time{}, fmt.Errorf("%w: %q: %s", ErrInvalidRelative, expr, reason)
+	}
+
+	var words []string
+	for _, w := range strings.Fields(strings.ToLower(strings.ReplaceAll(expr, ",", " "))) {
+		if w != "the" {
+			words = append(words, w)
+		}
+	}
+
+	words, tod, hasTime, ok := extractRelativeTime(words)
+	if !ok {
+		return invalid("bad time of day")
+	}
+
+	var t time.Time
+	if len(words) == 0 {
+		if !hasTime {
+			return invalid("empty expression")
+		}
+		t = SoD(now)
+	} else {
+		var reason string
+		t, reason = parseRelativeDate(words, now)
+		if reason != "" {
+			return invalid(reason)
+		}
+	}
+
+	if hasTime {
+		t = DateOf(t).At(tod, t.Location())
+	}
+	return t, nil
+}
+
+// extractRelativeTime removes the time of day from the words of a relative
+// expression. It reports false when the time is malformed or given twice.
+func extractRelativeTime(words []string) (rest []string, tod TimeOfDay, found, ok bool) {
+	for i := 0; i < len(words); i++ {
+		w := words[i]
+		at := w == "at"
+		if at {
+			if i+1 == len(words) {
+				return nil, TimeOfDay{}, false, false
+			}
+			w = words[i+1]
+		}
+		n := 1
+		if at {
+			n = 2
+		}
+		// A meridiem may be written as a separate word: "9 am".
+		if j := i + n; j < len(words) && (words[j] == "am" || words[j] == "pm") {
+			w += words[j]
+			n++
+		}
+
+		clock, isTime := parseRelativeClock(w, at)
+		if !isTime {
+			if at {
+				return nil, TimeOfDay{}, false, false
+			}
+			rest = append(rest, words[i])
+			continue
+		}
+		if found {
+			return nil, TimeOfDay{}, false, false
+		}
+		tod, found = clock, true
+		i += n - 1
+	}
+	return rest, tod, found, true
+}
+
+// parseRelativeClock parses a time of day such as "9am", "9:30pm", "17:30" or
+// "noon". A bare hour such as "9" is only accepted when bare is true.
+func parseRelativeClock(s string, bare bool) (TimeOfDay, bool) {
+	switch s {
+	case "noon":
+		return TimeOfDay{Hour: 12}, true
+	case "midnight":
+		return TimeOfDay{}, true
+	}
+
+	meridiem := ""
+	if strings.HasSuffix(s, "am") || strings.HasSuffix(s, "pm") {
+		meridiem, s = s[len(s)-2:], s[:len(s)-2]
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 || (len(parts) == 1 && meridiem == "" && !bare) {
+		return TimeOfDay{}, false
+	}
+
+	var fields [3]int
+	for i, p := range parts {
+		if p == "" || len(p) > 2 || (i > 0 && len(p) != 2) {
+			return TimeOfDay{}, false
+		}
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return TimeOfDay{}, false
+		}
+		fields[i] = n
+	}
+
+	hour := fields[0]
+	if meridiem != "" {
+		if hour < 1 || hour > 12 {
+			return TimeOfDay{}, false
+		}
+		hour %= 12
+		if meridiem == "pm" {
+			hour += 12
+		}
+	}
+	tod := TimeOfDay{Hour: hour, Minute: fields[1], Second: fields[2]}
+	return tod, tod.IsValid()
+}
+
+// parseRelativeDate parses the date part of a relative expression. It returns
+// the reason when the words are not understood.
+func parseRelativeDate(words []string, now time.Time) (time.Time, string) {
+	switch strings.Join(words, " ") {
+	case "now":
+		return now, ""
+	case "today":
+		return SoD(now), ""
+	case "tomorrow":
+		return SoD(Days(1, now)), ""
+	case "yesterday":
+		return SoD(Days(-1, now)), ""
+	case "day after tomorrow":
+		return SoD(Days(2, now)), ""
+	case "day before yesterday":
+		return SoD(Days(-2, now)), ""
+	}
+
+	n := len(words)
+	switch {
+	case words[0] == "in" && n > 1:
+		return addRelativePeriod(words[1:], false, now)
+	case words[n-1] == "ago" && n > 1:
+		return addRelativePeriod(words[:n-1], true, now)
+	case words[n-1] == "later" && n > 1:
+		return addRelativePeriod(words[:n-1], false, now)
+	case n > 2 && words[n-2] == "from" && words[n-1] == "now":
+		return addRelativePeriod(words[:n-2], false, now)
+	}
+
+	// <weekday>, this/next/last <weekday> and this/next/last <period>.
+	if day, ok := relativeWeekdays[words[0]]; ok && n == 1 {
+		return SoD(NextOrSameWeekday(day, now)), ""
+	}
+	if shift, ok := relativeShifts[words[0]]; ok && n == 2 {
+		if day, ok := relativeWeekdays[words[1]]; ok {
+			switch {
+			case shift > 0:
+				return SoD(NextWeekday(day, now)), ""
+			case shift < 0:
+				return SoD(PrevWeekday(day, now)), ""
+			}
+			return WeekStartOn(day, now), ""
+		}
+		if unit, anchor, ok := parseRelativeRef(words, now); ok {
+			return PeriodStart(unit, anchor), ""
+		}
+	}
+
+	if n < 3 {
+		return time.Time{}, "unknown expression"
+	}
+
+	// start/beginning/end of <period>.
+	if words[1] == "of" && (words[0] == "start" || words[0] == "beginning" || words[0] == "end") {
+		unit, anchor, ok := parseRelativeRef(words[2:], now)
+		if !ok {
+			return time.Time{}, "unknown period"
+		}
+		if words[0] == "end" {
+			return PeriodEnd(unit, anchor), ""
+		}
+		return PeriodStart(unit, anchor), ""
+	}
+
+	// first/last day of <period>.
+	if n > 3 && words[1] == "day" && words[2] == "of" && (words[0] == "first" || words[0] == "last") {
+		unit, anchor, ok := parseRelativeRef(words[3:], now)
+		if !ok {
+			return time.Time{}, "unknown period"
+		}
+		if words[0] == "last" {
+			return SoD(PeriodEnd(unit, anchor)), ""
+		}
+		return PeriodStart(unit, anchor), ""
+	}
+
+	// <ordinal> <weekday> of <period>.
+	if nth, ok := relativeOrdinals[words[0]]; ok && n > 3 && words[2] == "of" {
+		day, ok := relativeWeekdays[words[1]]
+		if !ok {
+			return time.Time{}, fmt.Sprintf("unknown weekday %q", words[1])
+		}
+		unit, anchor, ok := parseRelativeRef(words[3:], now)
+		if !ok {
+			return time.Time{}, "unknown period"
+		}
+		t, err := NthWeekdayInRange(nth, day, PeriodStart(unit, anchor), PeriodEnd(unit, anchor))
+		if err != nil {
+			return time.Time{}, fmt.Sprintf("no %s %s in that %s", words[0], words[1], unit)
+		}
+		return t, ""
+	}
+
+	return time.Time{}, "unknown expression"
+}
+
+// addRelativePeriod moves now by the human readable period in words, backwards
+// when ago is true.
+func addRelativePeriod(words []string, ago bool, now time.Time) (time.Time, string) {
+	hp, err := ParseHumanPeriod(strings.Join(words, " "))
+	if err != nil {
+		return time.Time{}, fmt.Sprintf("bad duration %q", strings.Join(words, " "))
+	}
+	if ago {
+		hp.Period = hp.Period.Negate()
+		hp.BusinessDays = -hp.BusinessDays
+	}
+	t, _ := hp.AddTo(now, mondayToFriday)
+	return t, ""
+}
+
+// parseRelativeRef parses a period reference such as "month", "next quarter",
+// "june" or "june 2026" and returns its unit and a time within the period.
+func parseRelativeRef(words []string, now time.Time) (PeriodUnit, time.Time, bool) {
+	if month, ok := relativeMonths[words[0]]; ok {
+		year := now.Year()
+		switch len(words) {
+		case 1:
+		case 2:
+			y, err := strconv.Atoi(words[1])
+			if err != nil || len(words[1]) != 4 {
+				return 0, time.Time{}, false
+			}
+			year = y
+		default:
+			return 0, time.Time{}, false
+		}
+		return PeriodMonth, time.Date(year, month, 1, 0, 0, 0, 0, now.Location()), true
+	}
+
+	shift := 0
+	if len(words) == 2 {
+		var ok bool
+		if shift, ok = relativeShifts[words[0]]; !ok {
+			return 0, time.Time{}, false
+		}
+		words = words[1:]
+	}
+	unit, ok := relativePeriods[words[0]]
+	if !ok || len(words) != 1 {
+		return 0, time.Time{}, false
+	}
+	// Shift from the start of the period, so that "next month" from January 31st
+	// is February rather than March.
+	return unit, AddPeriods(unit, shift, PeriodStart(unit, now)), true
+}
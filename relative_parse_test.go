@@ -0,0 +1,160 @@
+package gotime_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestParseRelative(t *testing.T) {
+	// Wednesday.
+	base := time.Date(2025, 7, 9, 14, 30, 0, 0, time.UTC)
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	endOfDay := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 23, 59, 59, 999999999, time.UTC)
+	}
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"now", base},
+		{"today", day(2025, 7, 9)},
+		{"tomorrow", day(2025, 7, 10)},
+		{"yesterday", day(2025, 7, 8)},
+		{"day after tomorrow", day(2025, 7, 11)},
+		{"the day before yesterday", day(2025, 7, 7)},
+
+		{"tomorrow 9am", time.Date(2025, 7, 10, 9, 0, 0, 0, time.UTC)},
+		{"yesterday at 17:30", time.Date(2025, 7, 8, 17, 30, 0, 0, time.UTC)},
+		{"noon tomorrow", time.Date(2025, 7, 10, 12, 0, 0, 0, time.UTC)},
+		{"9:15 pm", time.Date(2025, 7, 9, 21, 15, 0, 0, time.UTC)},
+		{"friday at 8", time.Date(2025, 7, 11, 8, 0, 0, 0, time.UTC)},
+		{"midnight", day(2025, 7, 9)},
+		{"12am", day(2025, 7, 9)},
+		{"12pm", time.Date(2025, 7, 9, 12, 0, 0, 0, time.UTC)},
+
+		{"friday", day(2025, 7, 11)},
+		{"wednesday", day(2025, 7, 9)},
+		{"this monday", day(2025, 7, 7)},
+		{"next friday", day(2025, 7, 11)},
+		{"Next Friday", day(2025, 7, 11)},
+		{"next wednesday", day(2025, 7, 16)},
+		{"last friday", day(2025, 7, 4)},
+		{"last wednesday", day(2025, 7, 2)},
+		{"last mon", day(2025, 7, 7)},
+
+		{"in 3 days", time.Date(2025, 7, 12, 14, 30, 0, 0, time.UTC)},
+		{"2 weeks ago", time.Date(2025, 6, 25, 14, 30, 0, 0, time.UTC)},
+		{"in 2 hours", time.Date(2025, 7, 9, 16, 30, 0, 0, time.UTC)},
+		{"an hour ago", time.Date(2025, 7, 9, 13, 30, 0, 0, time.UTC)},
+		{"in 1 month and 2 days", time.Date(2025, 8, 11, 14, 30, 0, 0, time.UTC)},
+		{"3 days from now at 8am", time.Date(2025, 7, 12, 8, 0, 0, 0, time.UTC)},
+		{"90 minutes later", time.Date(2025, 7, 9, 16, 0, 0, 0, time.UTC)},
+		{"in 3 business days", time.Date(2025, 7, 14, 14, 30, 0, 0, time.UTC)},
+
+		{"next week", day(2025, 7, 13)},
+		{"last week", day(2025, 6, 29)},
+		{"next month", day(2025, 8, 1)},
+		{"this quarter", day(2025, 7, 1)},
+		{"last year", day(2024, 1, 1)},
+
+		{"end of quarter", endOfDay(2025, 9, 30)},
+		{"end of the week", endOfDay(2025, 7, 12)},
+		{"start of next year", day(2026, 1, 1)},
+		{"beginning of last month", day(2025, 6, 1)},
+		{"end of june", endOfDay(2025, 6, 30)},
+
+		{"last day of next month", day(2025, 8, 31)},
+		{"first day of this month", day(2025, 7, 1)},
+		{"last day of february 2028", day(2028, 2, 29)},
+		{"last day of month at 5pm", time.Date(2025, 7, 31, 17, 0, 0, 0, time.UTC)},
+
+		{"first monday of june", day(2025, 6, 2)},
+		{"last friday of next month", day(2025, 8, 29)},
+		{"second tuesday of the month", day(2025, 7, 8)},
+		{"3rd thursday of november 2026", day(2026, 11, 19)},
+		{"last sunday of year", day(2025, 12, 28)},
+		{"first monday of next quarter", day(2025, 10, 6)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			got, err := gotime.ParseRelative(tc.expr, base)
+			utils.AssertEqual(t, nil, err)
+			utils.AssertEqual(t, tc.want, got)
+		})
+	}
+}
+
+func TestParseRelativeMonthOverflow(t *testing.T) {
+	base := time.Date(2025, 1, 31, 10, 0, 0, 0, time.UTC)
+
+	got, err := gotime.ParseRelative("last day of next month", base)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), got)
+
+	got, err = gotime.ParseRelative("next month", base)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), got)
+}
+
+func TestParseRelativeDST(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	base := time.Date(2025, 3, 8, 12, 0, 0, 0, ny)
+
+	// 2:30 does not exist on 2025-03-09 in New York.
+	got, err := gotime.ParseRelative("tomorrow 2:30am", base)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, time.Date(2025, 3, 9, 3, 30, 0, 0, ny), got)
+
+	got, err = gotime.ParseRelative("tomorrow", base)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, ny, got.Location())
+}
+
+func TestParseRelativeInvalid(t *testing.T) {
+	base := time.Date(2025, 7, 9, 14, 30, 0, 0, time.UTC)
+	for _, expr := range []string{
+		"",
+		"the",
+		"next",
+		"someday",
+		"in",
+		"5 fortnights ago",
+		"fifth monday of february",
+		"first funday of june",
+		"start of june 26",
+		"9am 10am",
+		"tomorrow yesterday",
+		"at",
+		"at dawn",
+		"25:00",
+		"13pm",
+		"9:5",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			_, err := gotime.ParseRelative(expr, base)
+			utils.AssertEqual(t, true, errors.Is(err, gotime.ErrInvalidRelative))
+		})
+	}
+}
+
+func ExampleParseRelative() {
+	base := time.Date(2025, 7, 9, 14, 30, 0, 0, time.UTC) // Wednesday
+
+	for _, expr := range []string{"next friday", "tomorrow 9am", "in 3 days", "last day of next month", "end of quarter", "first monday of june"} {
+		t, _ := gotime.ParseRelative(expr, base)
+		fmt.Println(t.Format("2006-01-02 15:04 Mon"))
+	}
+	// Output:
+	// 2025-07-11 00:00 Fri
+	// 2025-07-10 09:00 Thu
+	// 2025-07-12 14:30 Sat
+	// 2025-08-31 00:00 Sun
+	// 2025-09-30 23:59 Tue
+	// 2025-06-02 00:00 Mon
+}
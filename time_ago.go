@@ -49,8 +49,8 @@ func TimeAgo(t time.Time, baseTime ...time.Time) string {
 		timeSince = baseTime[0].Sub(t)
 		now = baseTime[0]
 	} else {
-		timeSince = time.Since(t)
-		now = time.Now()
+		now = Now()
+		timeSince = now.Sub(t)
 	}
 
 	// If timeSince is negative, then the date is in the future
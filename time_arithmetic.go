@@ -10,13 +10,7 @@ import "time"
 //   pastTime := gotime.Hours(-2, someTime)  // 2 hours before someTime
 //   noChange := gotime.Hours(0)             // same as time.Now()
 func Hours(hours int, dt ...time.Time) time.Time {
-	var t time.Time
-	if len(dt) > 0 {
-		t = dt[0]
-	} else {
-		t = time.Now()
-	}
-	return t.Add(time.Duration(hours) * time.Hour)
+	return AddPeriods(PeriodHour, hours, dt...)
 }
 
 // Minutes returns the time after adding the specified number of minutes to the given time.
@@ -27,13 +21,7 @@ func Hours(hours int, dt ...time.Time) time.Time {
 //   pastTime := gotime.Minutes(-15, someTime)  // 15 minutes before someTime
 //   noChange := gotime.Minutes(0)              // same as time.Now()
 func Minutes(minutes int, dt ...time.Time) time.Time {
-	var t time.Time
-	if len(dt) > 0 {
-		t = dt[0]
-	} else {
-		t = time.Now()
-	}
-	return t.Add(time.Duration(minutes) * time.Minute)
+	return AddPeriods(PeriodMinute, minutes, dt...)
 }
 
 // Seconds returns the time after adding the specified number of seconds to the given time.
@@ -44,11 +32,5 @@ func Minutes(minutes int, dt ...time.Time) time.Time {
 //   pastTime := gotime.Seconds(-30, someTime)  // 30 seconds before someTime
 //   noChange := gotime.Seconds(0)              // same as time.Now()
 func Seconds(seconds int, dt ...time.Time) time.Time {
-	var t time.Time
-	if len(dt) > 0 {
-		t = dt[0]
-	} else {
-		t = time.Now()
-	}
-	return t.Add(time.Duration(seconds) * time.Second)
+	return AddPeriods(PeriodSecond, seconds, dt...)
 }
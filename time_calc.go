@@ -73,6 +73,9 @@ func Diff(t1, t2 time.Time, unit time.Duration, rounded ...bool) float64 {
 }
 
 // Latest returns the latest (most recent) time from the given list of times.
+// The time is returned as given, with its precision, location and monotonic
+// clock reading intact. When several times are the same instant, the first one
+// is returned.
 //
 // Example:
 //
@@ -82,26 +85,22 @@ func Diff(t1, t2 time.Time, unit time.Duration, rounded ...bool) float64 {
 //	latest := gotime.Latest(t1, t2, t3)
 //	// latest: 2025-01-03 (t3)
 func Latest(t1, t2 time.Time, tn ...time.Time) time.Time {
-
-	timeStamps := make([]int64, len(tn)+2)
-	timeStamps[0] = t1.UnixMilli()
-	timeStamps[1] = t2.UnixMilli()
-	for i, t := range tn {
-		timeStamps[i+2] = t.UnixMilli()
+	latest := t1
+	if t2.After(latest) {
+		latest = t2
 	}
-
-	// Returing the largest unix timestamp
-	max := timeStamps[0]
-	for _, t := range timeStamps {
-		if t > max {
-			max = t
+	for _, t := range tn {
+		if t.After(latest) {
+			latest = t
 		}
 	}
-
-	return time.UnixMilli(max)
+	return latest
 }
 
 // Earliest returns the earliest (oldest) time from the given list of times.
+// The time is returned as given, with its precision, location and monotonic
+// clock reading intact. When several times are the same instant, the first one
+// is returned.
 //
 // Example:
 //
@@ -111,23 +110,16 @@ func Latest(t1, t2 time.Time, tn ...time.Time) time.Time {
 //	earliest := gotime.Earliest(t1, t2, t3)
 //	// earliest: 2025-01-01 (t1)
 func Earliest(t1, t2 time.Time, tn ...time.Time) time.Time {
-
-	timeStamps := make([]int64, len(tn)+2)
-	timeStamps[0] = t1.UnixMilli()
-	timeStamps[1] = t2.UnixMilli()
-	for i, t := range tn {
-		timeStamps[i+2] = t.UnixMilli()
+	earliest := t1
+	if t2.Before(earliest) {
+		earliest = t2
 	}
-
-	// Returing the smallest unix timestamp
-	min := timeStamps[0]
-	for _, t := range timeStamps {
-		if t < min {
-			min = t
+	for _, t := range tn {
+		if t.Before(earliest) {
+			earliest = t
 		}
 	}
-
-	return time.UnixMilli(min)
+	return earliest
 }
 
 // TruncateTime truncates the time portion of a date, setting hours, minutes,
@@ -179,22 +171,16 @@ func WorkDay(startDate time.Time, days int, workingDays [7]bool, holidays ...tim
 		return time.Time{}, ErrNoWorkingDays
 	}
 
-	// Create a map of holidays for O(1) lookup
-	holidayMap := make(map[string]bool)
-	for _, holiday := range holidays {
-		// Format as YYYY-MM-DD to handle date equality regardless of time
-		key := holiday.Format("2006-01-02")
-		holidayMap[key] = true
-	}
+	// Create a set of holidays for O(1) lookup. Each holiday is matched in
+	// its own location, regardless of the time of day.
+	holidayLookup := newHolidaySet(holidays)
 
 	currentDate := startDate
 	daysAdded := 0
 
 	for daysAdded < days {
-		dateKey := currentDate.Format("2006-01-02")
-
 		// Check if it's a working day and not a holiday
-		if workingDays[currentDate.Weekday()] && !holidayMap[dateKey] {
+		if workingDays[currentDate.Weekday()] && !holidayLookup.contains(currentDate) {
 			daysAdded++
 		}
 
@@ -240,23 +226,17 @@ func PrevWorkDay(startDate time.Time, days int, workingDays [7]bool, holidays ..
 		return time.Time{}, ErrNoWorkingDays
 	}
 
-	// Create a map of holidays for O(1) lookup
-	holidayMap := make(map[string]bool)
-	for _, holiday := range holidays {
-		// Format as YYYY-MM-DD to handle date equality regardless of time
-		key := holiday.Format("2006-01-02")
-		holidayMap[key] = true
-	}
+	// Create a set of holidays for O(1) lookup. Each holiday is matched in
+	// its own location, regardless of the time of day.
+	holidayLookup := newHolidaySet(holidays)
 
 	currentDate := startDate
 	daysSubtracted := 0
 
 	for daysSubtracted < days {
 		currentDate = currentDate.AddDate(0, 0, -1)
-		dateKey := currentDate.Format("2006-01-02")
-
 		// Skip if it's a weekend or a holiday
-		if !workingDays[currentDate.Weekday()] || holidayMap[dateKey] {
+		if !workingDays[currentDate.Weekday()] || holidayLookup.contains(currentDate) {
 			continue
 		}
 
@@ -297,12 +277,9 @@ func NetWorkDays(startDate, endDate time.Time, workingDays [7]bool, holidays ...
 		return 0, ErrNoWorkingDays
 	}
 
-	// Create a map of holidays for O(1) lookup
-	holidayMap := make(map[string]bool)
-	for _, holiday := range holidays {
-		key := holiday.Format("2006-01-02")
-		holidayMap[key] = true
-	}
+	// Create a set of holidays for O(1) lookup. Each holiday is matched in
+	// its own location, regardless of the time of day.
+	holidayLookup := newHolidaySet(holidays)
 
 	// Determine if we need to reverse the calculation direction
 	reverse := false
@@ -319,10 +296,8 @@ func NetWorkDays(startDate, endDate time.Time, workingDays [7]bool, holidays ...
 
 	// We need to include the current day in the calculation if it's a working day
 	for !currentDate.After(endDate) {
-		dateKey := currentDate.Format("2006-01-02")
-
 		// Check if it's a working day and not a holiday
-		if workingDays[currentDate.Weekday()] && !holidayMap[dateKey] {
+		if workingDays[currentDate.Weekday()] && !holidayLookup.contains(currentDate) {
 			workDays++
 		}
 
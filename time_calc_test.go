@@ -92,6 +92,25 @@ func TestEarliest(t *testing.T) {
 
 }
 
+func TestLatestEarliestPreserveValue(t *testing.T) {
+	tokyo := mustLoad(t, "Asia/Tokyo")
+	a := time.Date(2025, 7, 9, 10, 0, 0, 123456789, tokyo)
+	b := a.Add(-time.Microsecond).In(time.UTC)
+	c := a.Add(-time.Hour)
+
+	latest := gotime.Latest(b, a, c)
+	utils.AssertEqual(t, 123456789, latest.Nanosecond())
+	utils.AssertEqual(t, tokyo, latest.Location())
+
+	earliest := gotime.Earliest(a, b, c)
+	utils.AssertEqual(t, c.Nanosecond(), earliest.Nanosecond())
+	utils.AssertEqual(t, tokyo, earliest.Location())
+
+	// The first of equal instants wins.
+	utils.AssertEqual(t, time.UTC, gotime.Latest(a.In(time.UTC), a).Location())
+	utils.AssertEqual(t, tokyo, gotime.Earliest(a, a.In(time.UTC)).Location())
+}
+
 func TestTruncateTime(t *testing.T) {
 	now := time.Now()
 	expected := trunccateSecond(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()))
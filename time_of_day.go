@@ -0,0 +1,251 @@
+package gotime
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/maniartech/gotime/v2/internal/nites"
+)
+
+// isoTimeLayout is the layout used by TimeOfDay for its text, JSON and SQL forms.
+const isoTimeLayout = "15:04:05.999999999"
+
+// TimeOfDay is a wall clock time without a date or a location, such as an
+// opening hour or an alarm. The zero value is midnight.
+//
+// TimeOfDay values are comparable with == and can be used as map keys.
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// TimeOfDayOf returns the wall clock time of t in its own location.
+//
+// Example:
+//
+//	t := time.Date(2025, 7, 4, 9, 30, 0, 0, time.UTC)
+//	gotime.TimeOfDayOf(t) // 09:30:00
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	return TimeOfDay{Hour: t.Hour(), Minute: t.Minute(), Second: t.Second(), Nanosecond: t.Nanosecond()}
+}
+
+// TimeOfDayFromDuration returns the wall clock time the given duration after
+// midnight. Durations outside a day wrap around, so 25 hours is 01:00 and
+// -30 minutes is 23:30.
+//
+// Example:
+//
+//	gotime.TimeOfDayFromDuration(90 * time.Minute) // 01:30:00
+func TimeOfDayFromDuration(d time.Duration) TimeOfDay {
+	d %= 24 * time.Hour
+	if d < 0 {
+		d += 24 * time.Hour
+	}
+	return TimeOfDay{
+		Hour:       int(d / time.Hour),
+		Minute:     int(d % time.Hour / time.Minute),
+		Second:     int(d % time.Minute / time.Second),
+		Nanosecond: int(d % time.Second),
+	}
+}
+
+// ParseTimeOfDay parses a time string according to the specified NITES layout,
+// such as "hh:ii a" or "hhhh:ii:ss", and returns its wall clock time. Any date
+// in the value is ignored. If layout is empty, "hhhh:ii:ss" is used.
+//
+// Example:
+//
+//	tod, err := gotime.ParseTimeOfDay("hh:ii aa", "09:30 PM")
+//	// tod: 21:30:00
+func ParseTimeOfDay(layout, value string) (TimeOfDay, error) {
+	if layout == "" {
+		layout = "hhhh:ii:ss"
+	}
+	t, err := parseValue(layout, value, time.UTC)
+	if err != nil {
+		return TimeOfDay{}, err
+	}
+	return TimeOfDayOf(t), nil
+}
+
+// IsValid reports whether every component of t is within its normal range.
+func (t TimeOfDay) IsValid() bool {
+	return t.Hour >= 0 && t.Hour < 24 &&
+		t.Minute >= 0 && t.Minute < 60 &&
+		t.Second >= 0 && t.Second < 60 &&
+		t.Nanosecond >= 0 && t.Nanosecond < int(time.Second)
+}
+
+// SinceMidnight returns the duration from midnight to t.
+func (t TimeOfDay) SinceMidnight() time.Duration {
+	return time.Duration(t.Hour)*time.Hour +
+		time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second +
+		time.Duration(t.Nanosecond)
+}
+
+// Add returns the wall clock time d after t, wrapping at midnight.
+//
+// Example:
+//
+//	closing := gotime.TimeOfDay{Hour: 22}
+//	closing.Add(3 * time.Hour) // 01:00:00
+func (t TimeOfDay) Add(d time.Duration) TimeOfDay {
+	return TimeOfDayFromDuration(t.SinceMidnight() + d)
+}
+
+// Sub returns the duration t-u on the same day. It is negative when t is
+// before u.
+func (t TimeOfDay) Sub(u TimeOfDay) time.Duration {
+	return t.SinceMidnight() - u.SinceMidnight()
+}
+
+// Until returns how long it takes from t to reach the next occurrence of u,
+// wrapping at midnight. It is useful for shifts that cross midnight.
+//
+// Example:
+//
+//	start := gotime.TimeOfDay{Hour: 22}
+//	end := gotime.TimeOfDay{Hour: 6}
+//	start.Until(end) // 8h0m0s
+func (t TimeOfDay) Until(u TimeOfDay) time.Duration {
+	d := u.Sub(t)
+	if d < 0 {
+		d += 24 * time.Hour
+	}
+	return d
+}
+
+// Compare returns -1 if t is before u, 0 if they are equal and +1 if t is
+// after u.
+func (t TimeOfDay) Compare(u TimeOfDay) int {
+	switch d := t.Sub(u); {
+	case d < 0:
+		return -1
+	case d > 0:
+		return 1
+	}
+	return 0
+}
+
+// Before reports whether t is before u.
+func (t TimeOfDay) Before(u TimeOfDay) bool {
+	return t.Sub(u) < 0
+}
+
+// After reports whether t is after u.
+func (t TimeOfDay) After(u TimeOfDay) bool {
+	return t.Sub(u) > 0
+}
+
+// reference returns t on January 1st of year 0 in UTC, normalizing out of range
+// components.
+func (t TimeOfDay) reference() time.Time {
+	return time.Date(0, 1, 1, t.Hour, t.Minute, t.Second, t.Nanosecond, time.UTC)
+}
+
+// String returns the time in the form hhhh:ii:ss, followed by the fraction of
+// the second when it is not zero.
+func (t TimeOfDay) String() string {
+	return t.reference().Format(isoTimeLayout)
+}
+
+// Format returns the time formatted with the specified NITES layout. If layout
+// is empty, "hhhh:ii:ss" is used.
+//
+// Example:
+//
+//	alarm := gotime.TimeOfDay{Hour: 6, Minute: 45}
+//	alarm.Format("h:ii a") // "6:45 am"
+func (t TimeOfDay) Format(layout string) string {
+	if layout == "" {
+		layout = "hhhh:ii:ss"
+	}
+	return nites.Format(t.reference(), layout)
+}
+
+// At returns the instant at which the wall clock in loc reads tod on date d.
+// A time skipped by a DST transition is moved forward by the length of the gap
+// and a repeated time resolves to its first occurrence (see DSTCompatible).
+//
+// Example:
+//
+//	ny, _ := gotime.LoadLocation("America/New_York")
+//	d := gotime.Date{Year: 2025, Month: time.July, Day: 4}
+//	d.At(gotime.TimeOfDay{Hour: 9, Minute: 30}, ny) // 2025-07-04 09:30:00 -0400 EDT
+func (d Date) At(tod TimeOfDay, loc *time.Location) time.Time {
+	t, _ := d.AtWithPolicy(tod, loc, DSTCompatible)
+	return t
+}
+
+// AtWithPolicy is like At but resolves ambiguous and nonexistent wall clock
+// times with the given policy.
+func (d Date) AtWithPolicy(tod TimeOfDay, loc *time.Location, policy DSTPolicy) (time.Time, error) {
+	return DateInLocation(loc, d.Year, int(d.Month), d.Day, tod.Hour, tod.Minute, tod.Second, tod.Nanosecond, policy)
+}
+
+// MarshalText implements encoding.TextMarshaler using the same form as String.
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts hhhh:ii,
+// hhhh:ii:ss and hhhh:ii:ss followed by a fraction of the second.
+func (t *TimeOfDay) UnmarshalText(data []byte) error {
+	s := string(data)
+	parsed, err := time.Parse(isoTimeLayout, s)
+	if err != nil {
+		var errShort error
+		if parsed, errShort = time.Parse("15:04", s); errShort != nil {
+			return err
+		}
+	}
+	*t = TimeOfDayOf(parsed)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler using the same form as String.
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + t.String() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts the forms accepted by
+// UnmarshalText, and null, which sets t to midnight, the zero TimeOfDay.
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		*t = TimeOfDay{}
+		return nil
+	}
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return fmt.Errorf("gotime: TimeOfDay must be a JSON string, got %s", s)
+	}
+	return t.UnmarshalText([]byte(s[1 : len(s)-1]))
+}
+
+// Value implements driver.Valuer using the same form as String, which SQL TIME
+// columns accept.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	return t.String(), nil
+}
+
+// Scan implements sql.Scanner. It accepts a time.Time (using its own location)
+// and the text forms accepted by UnmarshalText. NULL scans as midnight.
+func (t *TimeOfDay) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*t = TimeOfDay{}
+		return nil
+	case time.Time:
+		*t = TimeOfDayOf(v)
+		return nil
+	case string:
+		return t.UnmarshalText([]byte(v))
+	case []byte:
+		return t.UnmarshalText(v)
+	}
+	return fmt.Errorf("%w: cannot scan %T into gotime.TimeOfDay", ErrUnsupportedScanType, src)
+}
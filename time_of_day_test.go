@@ -0,0 +1,154 @@
+package gotime_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestTimeOfDayArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		tod  gotime.TimeOfDay
+		d    time.Duration
+		want gotime.TimeOfDay
+	}{
+		{"Same day", gotime.TimeOfDay{Hour: 9}, 90 * time.Minute, gotime.TimeOfDay{Hour: 10, Minute: 30}},
+		{"Wraps forward", gotime.TimeOfDay{Hour: 22}, 3 * time.Hour, gotime.TimeOfDay{Hour: 1}},
+		{"Wraps backward", gotime.TimeOfDay{Hour: 0, Minute: 15}, -30 * time.Minute, gotime.TimeOfDay{Hour: 23, Minute: 45}},
+		{"Whole days", gotime.TimeOfDay{Hour: 8}, 48 * time.Hour, gotime.TimeOfDay{Hour: 8}},
+		{"Nanoseconds", gotime.TimeOfDay{Hour: 23, Minute: 59, Second: 59, Nanosecond: 999999999}, 1, gotime.TimeOfDay{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			utils.AssertEqual(t, tc.want, tc.tod.Add(tc.d))
+		})
+	}
+
+	start := gotime.TimeOfDay{Hour: 22}
+	end := gotime.TimeOfDay{Hour: 6}
+	utils.AssertEqual(t, 8*time.Hour, start.Until(end))
+	utils.AssertEqual(t, 16*time.Hour, end.Until(start))
+	utils.AssertEqual(t, time.Duration(0), end.Until(end))
+	utils.AssertEqual(t, -16*time.Hour, end.Sub(start))
+	utils.AssertEqual(t, 22*time.Hour, start.SinceMidnight())
+	utils.AssertEqual(t, gotime.TimeOfDay{Hour: 1, Minute: 30}, gotime.TimeOfDayFromDuration(25*time.Hour+30*time.Minute))
+}
+
+func TestTimeOfDayComparison(t *testing.T) {
+	a := gotime.TimeOfDay{Hour: 9, Minute: 30}
+	b := gotime.TimeOfDay{Hour: 9, Minute: 30, Nanosecond: 1}
+
+	utils.AssertEqual(t, true, a.Before(b))
+	utils.AssertEqual(t, true, b.After(a))
+	utils.AssertEqual(t, -1, a.Compare(b))
+	utils.AssertEqual(t, 1, b.Compare(a))
+	utils.AssertEqual(t, 0, a.Compare(a))
+
+	utils.AssertEqual(t, true, a.IsValid())
+	utils.AssertEqual(t, false, gotime.TimeOfDay{Hour: 24}.IsValid())
+	utils.AssertEqual(t, false, gotime.TimeOfDay{Minute: -1}.IsValid())
+}
+
+func TestTimeOfDayFormatAndParse(t *testing.T) {
+	tod := gotime.TimeOfDay{Hour: 21, Minute: 5, Second: 9}
+	utils.AssertEqual(t, "21:05:09", tod.String())
+	utils.AssertEqual(t, "21:05:09", tod.Format(""))
+	utils.AssertEqual(t, "09:05 pm", tod.Format("hh:ii a"))
+	utils.AssertEqual(t, "9:5:9 PM", tod.Format("h:i:s aa"))
+	utils.AssertEqual(t, "21:05:09.25", gotime.TimeOfDay{Hour: 21, Minute: 5, Second: 9, Nanosecond: 250000000}.String())
+
+	tests := []struct {
+		layout string
+		value  string
+		want   gotime.TimeOfDay
+	}{
+		{"", "21:05:09", tod},
+		{"hh:ii aa", "09:30 PM", gotime.TimeOfDay{Hour: 21, Minute: 30}},
+		{"h:ii a", "6:45 am", gotime.TimeOfDay{Hour: 6, Minute: 45}},
+		{"yyyy-mm-dd hhhh:ii", "2025-07-04 12:00", gotime.TimeOfDay{Hour: 12}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			got, err := gotime.ParseTimeOfDay(tc.layout, tc.value)
+			utils.AssertEqual(t, nil, err)
+			utils.AssertEqual(t, tc.want, got)
+		})
+	}
+
+	_, err := gotime.ParseTimeOfDay("hhhh:ii", "25:00")
+	utils.AssertEqual(t, true, err != nil)
+}
+
+func TestDateAt(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	d := gotime.Date{Year: 2025, Month: time.July, Day: 4}
+	got := d.At(gotime.TimeOfDay{Hour: 9, Minute: 30}, ny)
+	utils.AssertEqual(t, true, got.Equal(time.Date(2025, 7, 4, 13, 30, 0, 0, time.UTC)))
+	utils.AssertEqual(t, d, gotime.DateOf(got))
+	utils.AssertEqual(t, gotime.TimeOfDay{Hour: 9, Minute: 30}, gotime.TimeOfDayOf(got))
+
+	// 02:30 does not exist on 2025-03-09 and happens twice on 2025-11-02.
+	spring := gotime.Date{Year: 2025, Month: time.March, Day: 9}
+	got = spring.At(gotime.TimeOfDay{Hour: 2, Minute: 30}, ny)
+	utils.AssertEqual(t, true, got.Equal(time.Date(2025, 3, 9, 7, 30, 0, 0, time.UTC)))
+
+	fall := gotime.Date{Year: 2025, Month: time.November, Day: 2}
+	got = fall.At(gotime.TimeOfDay{Hour: 1, Minute: 30}, ny)
+	utils.AssertEqual(t, true, got.Equal(time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC)))
+
+	got, err := fall.AtWithPolicy(gotime.TimeOfDay{Hour: 1, Minute: 30}, ny, gotime.DSTLatest)
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, true, got.Equal(time.Date(2025, 11, 2, 6, 30, 0, 0, time.UTC)))
+
+	_, err = spring.AtWithPolicy(gotime.TimeOfDay{Hour: 2, Minute: 30}, ny, gotime.DSTReject)
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrNonexistentTime))
+}
+
+func TestTimeOfDayEncoding(t *testing.T) {
+	type shift struct {
+		Start gotime.TimeOfDay `json:"start"`
+		End   gotime.TimeOfDay `json:"end"`
+	}
+
+	data, err := json.Marshal(shift{gotime.TimeOfDay{Hour: 22}, gotime.TimeOfDay{Hour: 6, Minute: 30}})
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, `{"start":"22:00:00","end":"06:30:00"}`, string(data))
+
+	var s shift
+	utils.AssertEqual(t, nil, json.Unmarshal([]byte(`{"start":"22:15","end":"06:30:00.5"}`), &s))
+	utils.AssertEqual(t, gotime.TimeOfDay{Hour: 22, Minute: 15}, s.Start)
+	utils.AssertEqual(t, gotime.TimeOfDay{Hour: 6, Minute: 30, Nanosecond: 500000000}, s.End)
+	utils.AssertEqual(t, true, json.Unmarshal([]byte(`{"start":"25:00"}`), &s) != nil)
+	utils.AssertEqual(t, true, json.Unmarshal([]byte(`{"start":1200}`), &s) != nil)
+
+	// null resets a reused value, as it does for Date.
+	s = shift{gotime.TimeOfDay{Hour: 22}, gotime.TimeOfDay{Hour: 6}}
+	utils.AssertEqual(t, nil, json.Unmarshal([]byte(`{"start":null}`), &s))
+	utils.AssertEqual(t, gotime.TimeOfDay{}, s.Start)
+	utils.AssertEqual(t, gotime.TimeOfDay{Hour: 6}, s.End)
+
+	v, err := gotime.TimeOfDay{Hour: 8, Minute: 15}.Value()
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, "08:15:00", v)
+
+	var tod gotime.TimeOfDay
+	utils.AssertEqual(t, nil, tod.Scan([]byte("08:15:00")))
+	utils.AssertEqual(t, gotime.TimeOfDay{Hour: 8, Minute: 15}, tod)
+	utils.AssertEqual(t, nil, tod.Scan(time.Date(0, 1, 1, 17, 0, 0, 0, time.UTC)))
+	utils.AssertEqual(t, gotime.TimeOfDay{Hour: 17}, tod)
+	utils.AssertEqual(t, true, errors.Is(tod.Scan(3.5), gotime.ErrUnsupportedScanType))
+}
+
+func ExampleTimeOfDay() {
+	opening := gotime.TimeOfDay{Hour: 22}
+	closing := opening.Add(7 * time.Hour)
+	fmt.Println(closing.Format("h:ii a"), opening.Until(closing))
+	// Output: 5:00 am 7h0m0s
+}
@@ -0,0 +1,200 @@
+package gotime
+
+import (
+	"sort"
+	"time"
+)
+
+// MinMax returns the earliest and the latest of the given times in a single
+// pass. The times are returned as given, with their precision and location
+// intact. Both results are the zero time when no times are given.
+//
+// Example:
+//
+//	t1 := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+//	t2 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+//	t3 := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
+//	earliest, latest := gotime.MinMax(t1, t2, t3)
+//	// earliest: 2025-01-01, latest: 2025-01-03
+func MinMax(times ...time.Time) (earliest, latest time.Time) {
+	if len(times) == 0 {
+		return time.Time{}, time.Time{}
+	}
+	earliest, latest = times[0], times[0]
+	for _, t := range times[1:] {
+		if t.Before(earliest) {
+			earliest = t
+		}
+		if t.After(latest) {
+			latest = t
+		}
+	}
+	return earliest, latest
+}
+
+// SortTimes sorts the times in place in chronological order. Times that are the
+// same instant keep their original order.
+//
+// Example:
+//
+//	times := []time.Time{
+//		time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+//		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+//	}
+//	gotime.SortTimes(times)
+//	// times: [2025-01-01, 2025-01-03]
+func SortTimes(times []time.Time) {
+	sort.SliceStable(times, func(i, j int) bool {
+		return times[i].Before(times[j])
+	})
+}
+
+// Clamp limits t to the range from lo to hi, inclusive. It returns lo when t is
+// before lo, hi when t is after hi, and t otherwise. The bounds are swapped if
+// lo is after hi.
+//
+// Example:
+//
+//	lo := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+//	hi := time.Date(2025, 1, 1, 17, 0, 0, 0, time.UTC)
+//	gotime.Clamp(time.Date(2025, 1, 1, 20, 0, 0, 0, time.UTC), lo, hi) // 2025-01-01 17:00:00
+//	gotime.Clamp(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC), lo, hi) // 2025-01-01 12:00:00
+func Clamp(t, lo, hi time.Time) time.Time {
+	if lo.After(hi) {
+		lo, hi = hi, lo
+	}
+	if t.Before(lo) {
+		return lo
+	}
+	if t.After(hi) {
+		return hi
+	}
+	return t
+}
+
+// Nearest returns the candidate closest to t, in either direction. When two
+// candidates are equally close, the earlier one is returned. The zero time is
+// returned when there are no candidates.
+//
+// Example:
+//
+//	slots := []time.Time{
+//		time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC),
+//		time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+//		time.Date(2025, 1, 1, 15, 0, 0, 0, time.UTC),
+//	}
+//	gotime.Nearest(time.Date(2025, 1, 1, 13, 0, 0, 0, time.UTC), slots) // 2025-01-01 12:00:00
+func Nearest(t time.Time, candidates []time.Time) time.Time {
+	var nearest time.Time
+	var bestSec int64
+	var bestNsec int
+	for i, c := range candidates {
+		sec, nsec := distance(c, t)
+		closer := sec < bestSec || (sec == bestSec && nsec < bestNsec)
+		tie := sec == bestSec && nsec == bestNsec
+		if i == 0 || closer || (tie && c.Before(nearest)) {
+			nearest, bestSec, bestNsec = c, sec, nsec
+		}
+	}
+	return nearest
+}
+
+// distance returns the absolute time between a and b as whole seconds and
+// nanoseconds. Unlike time.Duration it does not saturate for times centuries
+// apart.
+func distance(a, b time.Time) (sec int64, nsec int) {
+	sec, nsec = a.Unix()-b.Unix(), a.Nanosecond()-b.Nanosecond()
+	if nsec < 0 {
+		sec, nsec = sec-1, nsec+1e9
+	}
+	if sec < 0 {
+		sec, nsec = -sec, -nsec
+		if nsec < 0 {
+			sec, nsec = sec-1, nsec+1e9
+		}
+	}
+	return sec, nsec
+}
+
+// Dedupe returns the times with duplicates removed, where two times are
+// duplicates when they fall in the same period of the given unit, such as the
+// same minute or the same day. The first time of each period is kept and the
+// order of the input is preserved. Calendar periods are read in each time's own
+// location, and unknown units remove only times that are the same instant. The
+// input slice is not modified.
+//
+// Example:
+//
+//	times := []time.Time{
+//		time.Date(2025, 7, 9, 10, 0, 15, 0, time.UTC),
+//		time.Date(2025, 7, 9, 10, 0, 45, 0, time.UTC),
+//		time.Date(2025, 7, 9, 10, 1, 5, 0, time.UTC),
+//	}
+//	gotime.Dedupe(times, gotime.PeriodMinute)
+//	// [10:00:15, 10:01:05]
+func Dedupe(times []time.Time, unit PeriodUnit) []time.Time {
+	seen := make(map[instantKey]bool, len(times))
+	result := make([]time.Time, 0, len(times))
+	for _, t := range times {
+		key := keyOf(PeriodStart(unit, t))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, t)
+	}
+	return result
+}
+
+// Bucket is a period holding the times that fall in it, as returned by
+// Bucketize.
+type Bucket struct {
+	// Start is the first instant of the period.
+	Start time.Time
+	// End is the last nanosecond of the period.
+	End time.Time
+	// Times are the times in the period, in their input order.
+	Times []time.Time
+}
+
+// Count returns the number of times in the bucket.
+func (b Bucket) Count() int {
+	return len(b.Times)
+}
+
+// Bucketize groups the times by the period of the given unit that contains them,
+// for building histograms. The buckets are returned in chronological order and
+// periods without any time are omitted. Calendar periods are read in each time's
+// own location. Unknown units put every distinct instant in its own bucket.
+//
+// Example:
+//
+//	times := []time.Time{
+//		time.Date(2025, 7, 9, 10, 5, 0, 0, time.UTC),
+//		time.Date(2025, 7, 9, 12, 0, 0, 0, time.UTC),
+//		time.Date(2025, 7, 9, 10, 55, 0, 0, time.UTC),
+//	}
+//	for _, b := range gotime.Bucketize(times, gotime.PeriodHour) {
+//		fmt.Println(b.Start.Format("15:04"), b.Count())
+//	}
+//	// 10:00 2
+//	// 12:00 1
+func Bucketize(times []time.Time, unit PeriodUnit) []Bucket {
+	index := map[instantKey]int{}
+	var buckets []Bucket
+	for _, t := range times {
+		start := PeriodStart(unit, t)
+		key := keyOf(start)
+		i, ok := index[key]
+		if !ok {
+			i = len(buckets)
+			index[key] = i
+			buckets = append(buckets, Bucket{Start: start, End: PeriodEnd(unit, t)})
+		}
+		buckets[i].Times = append(buckets[i].Times, t)
+	}
+	sort.SliceStable(buckets, func(i, j int) bool {
+		return buckets[i].Start.Before(buckets[j].Start)
+	})
+	return buckets
+}
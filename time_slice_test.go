@@ -0,0 +1,167 @@
+package gotime_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestMinMax(t *testing.T) {
+	tokyo := mustLoad(t, "Asia/Tokyo")
+	a := time.Date(2025, 7, 9, 10, 0, 0, 1, tokyo)
+	b := time.Date(2025, 7, 9, 0, 0, 0, 0, time.UTC) // 09:00 in Tokyo
+	c := time.Date(2025, 7, 9, 12, 0, 0, 0, tokyo)
+
+	earliest, latest := gotime.MinMax(a, b, c)
+	utils.AssertEqual(t, b, earliest)
+	utils.AssertEqual(t, time.UTC, earliest.Location())
+	utils.AssertEqual(t, c, latest)
+	utils.AssertEqual(t, tokyo, latest.Location())
+
+	earliest, latest = gotime.MinMax(a)
+	utils.AssertEqual(t, a, earliest)
+	utils.AssertEqual(t, a, latest)
+
+	earliest, latest = gotime.MinMax()
+	utils.AssertEqual(t, true, earliest.IsZero())
+	utils.AssertEqual(t, true, latest.IsZero())
+}
+
+func TestSortTimes(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 7, d, 0, 0, 0, 0, time.UTC) }
+	same := day(2).In(mustLoad(t, "Asia/Tokyo"))
+
+	times := []time.Time{day(3), day(2), day(5), same, day(1)}
+	gotime.SortTimes(times)
+	utils.AssertEqual(t, []time.Time{day(1), day(2), same, day(3), day(5)}, times)
+	utils.AssertEqual(t, time.UTC, times[1].Location())
+	utils.AssertEqual(t, same.Location(), times[2].Location())
+
+	gotime.SortTimes(nil)
+}
+
+func TestClamp(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2025, 7, 9, h, 0, 0, 0, time.UTC) }
+	lo, hi := at(9), at(17)
+
+	tests := []struct {
+		t, want time.Time
+	}{
+		{at(6), lo},
+		{at(9), lo},
+		{at(12), at(12)},
+		{at(17), hi},
+		{at(20), hi},
+	}
+	for _, tc := range tests {
+		utils.AssertEqual(t, tc.want, gotime.Clamp(tc.t, lo, hi))
+		utils.AssertEqual(t, tc.want, gotime.Clamp(tc.t, hi, lo))
+	}
+}
+
+func TestNearest(t *testing.T) {
+	at := func(h, m int) time.Time { return time.Date(2025, 7, 9, h, m, 0, 0, time.UTC) }
+	slots := []time.Time{at(15, 0), at(9, 0), at(12, 0)}
+
+	tests := []struct {
+		t, want time.Time
+	}{
+		{at(8, 0), at(9, 0)},
+		{at(11, 0), at(12, 0)},
+		{at(13, 0), at(12, 0)},
+		{at(13, 30), at(12, 0)}, // ties go to the earlier candidate
+		{at(23, 0), at(15, 0)},
+		{at(12, 0), at(12, 0)},
+	}
+	for _, tc := range tests {
+		utils.AssertEqual(t, tc.want, gotime.Nearest(tc.t, slots))
+	}
+
+	utils.AssertEqual(t, true, gotime.Nearest(at(12, 0), nil).IsZero())
+
+	// Sub-second distances are compared exactly.
+	base := at(12, 0)
+	utils.AssertEqual(t, base.Add(-300*time.Millisecond), gotime.Nearest(base, []time.Time{base.Add(400 * time.Millisecond), base.Add(-300 * time.Millisecond)}))
+
+	// Candidates far apart don't overflow the distance.
+	far := []time.Time{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)}
+	utils.AssertEqual(t, far[1], gotime.Nearest(time.Date(9000, 1, 1, 0, 0, 0, 0, time.UTC), far))
+}
+
+func TestDedupe(t *testing.T) {
+	at := func(d, h, m, s int) time.Time { return time.Date(2025, 7, d, h, m, s, 0, time.UTC) }
+	times := []time.Time{
+		at(9, 10, 0, 45),
+		at(9, 10, 0, 15),
+		at(9, 10, 1, 5),
+		at(10, 8, 0, 0),
+		at(9, 23, 0, 0),
+	}
+
+	utils.AssertEqual(t, []time.Time{at(9, 10, 0, 45), at(9, 10, 1, 5), at(10, 8, 0, 0), at(9, 23, 0, 0)}, gotime.Dedupe(times, gotime.PeriodMinute))
+	utils.AssertEqual(t, []time.Time{at(9, 10, 0, 45), at(10, 8, 0, 0)}, gotime.Dedupe(times, gotime.PeriodDay))
+	utils.AssertEqual(t, []time.Time{at(9, 10, 0, 45)}, gotime.Dedupe(times, gotime.PeriodMonth))
+	utils.AssertEqual(t, 5, len(times))
+
+	// Unknown units remove only equal instants, whatever their location.
+	dup := []time.Time{at(9, 10, 0, 0), at(9, 10, 0, 0).In(mustLoad(t, "Asia/Tokyo")), at(9, 10, 0, 1)}
+	utils.AssertEqual(t, []time.Time{dup[0], dup[2]}, gotime.Dedupe(dup, gotime.PeriodUnit(0)))
+
+	utils.AssertEqual(t, []time.Time{}, gotime.Dedupe(nil, gotime.PeriodDay))
+}
+
+func TestBucketize(t *testing.T) {
+	at := func(d, h int) time.Time { return time.Date(2025, 7, d, h, 0, 0, 0, time.UTC) }
+	times := []time.Time{at(10, 9), at(8, 12), at(10, 1), at(8, 6), at(12, 0)}
+
+	buckets := gotime.Bucketize(times, gotime.PeriodDay)
+	utils.AssertEqual(t, 3, len(buckets))
+
+	utils.AssertEqual(t, at(8, 0), buckets[0].Start)
+	utils.AssertEqual(t, time.Date(2025, 7, 8, 23, 59, 59, 999999999, time.UTC), buckets[0].End)
+	utils.AssertEqual(t, []time.Time{at(8, 12), at(8, 6)}, buckets[0].Times)
+
+	utils.AssertEqual(t, at(10, 0), buckets[1].Start)
+	utils.AssertEqual(t, []time.Time{at(10, 9), at(10, 1)}, buckets[1].Times)
+	utils.AssertEqual(t, 2, buckets[1].Count())
+
+	utils.AssertEqual(t, at(12, 0), buckets[2].Start)
+	utils.AssertEqual(t, 1, buckets[2].Count())
+
+	// Sunday 6 to Saturday 12 is a single week.
+	weeks := gotime.Bucketize(append(times, at(13, 0)), gotime.PeriodWeek)
+	utils.AssertEqual(t, 2, len(weeks))
+	utils.AssertEqual(t, at(6, 0), weeks[0].Start)
+	utils.AssertEqual(t, 5, weeks[0].Count())
+	utils.AssertEqual(t, at(13, 0), weeks[1].Start)
+
+	utils.AssertEqual(t, 0, len(gotime.Bucketize(nil, gotime.PeriodDay)))
+}
+
+func ExampleBucketize() {
+	times := []time.Time{
+		time.Date(2025, 7, 9, 10, 5, 0, 0, time.UTC),
+		time.Date(2025, 7, 9, 12, 0, 0, 0, time.UTC),
+		time.Date(2025, 7, 9, 10, 55, 0, 0, time.UTC),
+	}
+	for _, b := range gotime.Bucketize(times, gotime.PeriodHour) {
+		fmt.Println(b.Start.Format("15:04"), b.Count())
+	}
+	// Output:
+	// 10:00 2
+	// 12:00 1
+}
+
+func ExampleClamp() {
+	lo := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	hi := time.Date(2025, 1, 1, 17, 0, 0, 0, time.UTC)
+
+	fmt.Println(gotime.Clamp(time.Date(2025, 1, 1, 20, 0, 0, 0, time.UTC), lo, hi).Format("15:04"))
+	fmt.Println(gotime.Clamp(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC), lo, hi).Format("15:04"))
+	// Output:
+	// 17:00
+	// 12:00
+}
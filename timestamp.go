@@ -0,0 +1,167 @@
+package gotime
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidTimestamp is returned when a value can't be read as a Unix
+// timestamp.
+var ErrInvalidTimestamp = errors.New("invalid timestamp")
+
+// Magnitudes from which ParseTimestamp reads a timestamp as milliseconds,
+// microseconds and nanoseconds. Seconds cover the years 1966 to 5138, and each
+// finer unit takes over where the coarser one would be past that.
+const (
+	millisThreshold = 1e11
+	microsThreshold = 1e14
+	nanosThreshold  = 1e17
+)
+
+// ParseTimestamp parses a Unix timestamp in seconds, milliseconds, microseconds
+// or nanoseconds, detecting the unit by the magnitude of the value:
+//
+//	|value| < 1e11        seconds
+//	|value| < 1e14        milliseconds
+//	|value| < 1e17        microseconds
+//	otherwise             nanoseconds
+//
+// The value can be any integer or float type, a json.Number, or a string of
+// decimal digits with an optional sign and fractional part, such as
+// "1720512000" or "1720512000.250". The time is returned in UTC.
+//
+// Detection can't tell apart timestamps that are valid in more than one unit,
+// such as seconds after the year 5138 or milliseconds before 1973. Use
+// ParseTimestampUnit when the unit is known.
+//
+// Example:
+//
+//	gotime.ParseTimestamp(1720512000)              // 2024-07-09 08:00:00 +0000 UTC
+//	gotime.ParseTimestamp("1720512000123")         // 2024-07-09 08:00:00.123 +0000 UTC
+//	gotime.ParseTimestamp(int64(1720512000123456)) // 2024-07-09 08:00:00.123456 +0000 UTC
+func ParseTimestamp(value any) (time.Time, error) {
+	return parseTimestamp(value, 0)
+}
+
+// ParseTimestampUnit parses a Unix timestamp counted in the given unit, which
+// must be time.Second, time.Millisecond, time.Microsecond or time.Nanosecond.
+// It accepts the same values as ParseTimestamp, and the time is returned in UTC.
+//
+// Example:
+//
+//	gotime.ParseTimestampUnit(1720512000, time.Millisecond) // 1970-01-20 21:55:12 +0000 UTC
+//	gotime.ParseTimestampUnit("1.5", time.Second)           // 1970-01-01 00:00:01.5 +0000 UTC
+func ParseTimestampUnit(value any, unit time.Duration) (time.Time, error) {
+	switch unit {
+	case time.Second, time.Millisecond, time.Microsecond, time.Nanosecond:
+		return parseTimestamp(value, unit)
+	}
+	return time.Time{}, fmt.Errorf("%w: %v: unsupported unit %v", ErrInvalidTimestamp, value, unit)
+}
+
+// parseTimestamp parses value in unit, or detects the unit when unit is 0.
+func parseTimestamp(value any, unit time.Duration) (time.Time, error) {
+	var s string
+	switch v := value.(type) {
+	case int:
+		s = strconv.FormatInt(int64(v), 10)
+	case int8:
+		s = strconv.FormatInt(int64(v), 10)
+	case int16:
+		s = strconv.FormatInt(int64(v), 10)
+	case int32:
+		s = strconv.FormatInt(int64(v), 10)
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case uint:
+		s = strconv.FormatUint(uint64(v), 10)
+	case uint8:
+		s = strconv.FormatUint(uint64(v), 10)
+	case uint16:
+		s = strconv.FormatUint(uint64(v), 10)
+	case uint32:
+		s = strconv.FormatUint(uint64(v), 10)
+	case uint64:
+		s = strconv.FormatUint(v, 10)
+	case float32:
+		s = strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case json.Number:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return time.Time{}, fmt.Errorf("%w: %v: unsupported type %T", ErrInvalidTimestamp, value, value)
+	}
+
+	t, reason := parseTimestampString(strings.TrimSpace(s), unit)
+	if reason != "" {
+		return time.Time{}, fmt.Errorf("%w: %q: %s", ErrInvalidTimestamp, s, reason)
+	}
+	return t, nil
+}
+
+// parseTimestampString parses a decimal timestamp in unit, or detects the unit
+// when unit is 0. It returns the reason when s is invalid.
+func parseTimestampString(s string, unit time.Duration) (time.Time, string) {
+	negative := strings.HasPrefix(s, "-")
+	if negative || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	intPart, fracPart, hasFrac := strings.Cut(s, ".")
+	if !isDigits(intPart) || (hasFrac && !isDigits(fracPart)) {
+		return time.Time{}, "not a decimal number"
+	}
+
+	n, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return time.Time{}, "out of range"
+	}
+
+	if unit == 0 {
+		switch {
+		case n < millisThreshold:
+			unit = time.Second
+		case n < microsThreshold:
+			unit = time.Millisecond
+		case n < nanosThreshold:
+			unit = time.Microsecond
+		default:
+			unit = time.Nanosecond
+		}
+	}
+
+	// Split the whole units into seconds and nanoseconds, then add the
+	// fraction of a unit, which is truncated to nanosecond precision.
+	perSecond := int64(time.Second / unit)
+	sec, nsec := n/perSecond, n%perSecond*int64(unit)
+	if fracPart != "" {
+		if len(fracPart) > 9 {
+			fracPart = fracPart[:9]
+		}
+		frac, _ := strconv.ParseInt(fracPart+strings.Repeat("0", 9-len(fracPart)), 10, 64)
+		nsec += frac * int64(unit) / int64(time.Second)
+	}
+	if negative {
+		sec, nsec = -sec, -nsec
+	}
+	return time.Unix(sec, nsec).UTC(), ""
+}
+
+// isDigits reports whether s is a non-empty run of the digits 0-9.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
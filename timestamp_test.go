@@ -0,0 +1,125 @@
+package gotime_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	base := time.Date(2024, 7, 9, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value any
+		want  time.Time
+	}{
+		{1720512000, base},
+		{int64(1720512000), base},
+		{uint32(1720512000), base},
+		{"1720512000", base},
+		{" 1720512000\n", base},
+		{"+1720512000", base},
+		{json.Number("1720512000"), base},
+		{1720512000.5, base.Add(500 * time.Millisecond)},
+		{"1720512000.123456789123", base.Add(123456789)},
+		{int64(1720512000123), base.Add(123 * time.Millisecond)},
+		{"1720512000123", base.Add(123 * time.Millisecond)},
+		{float64(1720512000123), base.Add(123 * time.Millisecond)},
+		{"1720512000123.5", base.Add(123*time.Millisecond + 500*time.Microsecond)},
+		{int64(1720512000123456), base.Add(123456 * time.Microsecond)},
+		{uint64(1720512000123456789), base.Add(123456789)},
+		{"1720512000123456789", base.Add(123456789)},
+		{0, time.Unix(0, 0).UTC()},
+		{-86400, time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"-1.5", time.Unix(-2, 5e8).UTC()},
+		{int64(-1720512000123), time.UnixMilli(-1720512000123).UTC()},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprint(tc.value), func(t *testing.T) {
+			got, err := gotime.ParseTimestamp(tc.value)
+			utils.AssertEqual(t, nil, err)
+			utils.AssertEqual(t, tc.want, got)
+			utils.AssertEqual(t, time.UTC, got.Location())
+		})
+	}
+}
+
+func TestParseTimestampUnit(t *testing.T) {
+	tests := []struct {
+		value any
+		unit  time.Duration
+		want  time.Time
+	}{
+		{1720512000, time.Second, time.Date(2024, 7, 9, 8, 0, 0, 0, time.UTC)},
+		{1720512000, time.Millisecond, time.Date(1970, 1, 20, 21, 55, 12, 0, time.UTC)},
+		{1720512000, time.Microsecond, time.Date(1970, 1, 1, 0, 28, 40, 512000000, time.UTC)},
+		{1720512000, time.Nanosecond, time.Date(1970, 1, 1, 0, 0, 1, 720512000, time.UTC)},
+		{"1.5", time.Second, time.Unix(1, 5e8).UTC()},
+		{"1.5", time.Millisecond, time.Unix(0, 1500000).UTC()},
+		{"1.5", time.Nanosecond, time.Unix(0, 1).UTC()},
+		// Seconds past the year 5138 are only reachable with an explicit unit.
+		{int64(200000000000), time.Second, time.Unix(200000000000, 0).UTC()},
+	}
+	for _, tc := range tests {
+		got, err := gotime.ParseTimestampUnit(tc.value, tc.unit)
+		utils.AssertEqual(t, nil, err)
+		utils.AssertEqual(t, tc.want, got)
+	}
+
+	_, err := gotime.ParseTimestampUnit(1720512000, time.Minute)
+	utils.AssertEqual(t, true, errors.Is(err, gotime.ErrInvalidTimestamp))
+}
+
+func TestParseTimestampInvalid(t *testing.T) {
+	for _, value := range []any{
+		"",
+		"abc",
+		"12a",
+		"1.2.3",
+		"1.",
+		".5",
+		"--1",
+		"+-1",
+		"1e10",
+		"99999999999999999999",
+		uint64(1 << 63),
+		true,
+		nil,
+		time.Now(),
+	} {
+		t.Run(fmt.Sprint(value), func(t *testing.T) {
+			_, err := gotime.ParseTimestamp(value)
+			utils.AssertEqual(t, true, errors.Is(err, gotime.ErrInvalidTimestamp))
+		})
+	}
+}
+
+func TestUnixLayouts(t *testing.T) {
+	date := time.Date(2024, 7, 9, 8, 0, 0, 250000000, time.UTC)
+	utils.AssertEqual(t, "1720512000", gotime.Format(date, "unix"))
+	utils.AssertEqual(t, "1720512000250", gotime.Format(date, "unixms"))
+
+	parsed, err := gotime.Parse("unixms", "1720512000250")
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, date, parsed)
+
+	converted, err := gotime.Convert("1720512000", "unix", "yyyy-mm-dd")
+	utils.AssertEqual(t, nil, err)
+	utils.AssertEqual(t, "2024-07-09", converted)
+}
+
+func ExampleParseTimestamp() {
+	for _, v := range []any{1720512000, "1720512000123", int64(1720512000123456)} {
+		t, _ := gotime.ParseTimestamp(v)
+		fmt.Println(t.Format(time.RFC3339Nano))
+	}
+	// Output:
+	// 2024-07-09T08:00:00Z
+	// 2024-07-09T08:00:00.123Z
+	// 2024-07-09T08:00:00.123456Z
+}
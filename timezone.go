@@ -0,0 +1,292 @@
+package gotime
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Errors returned when a wall clock time cannot be mapped to a single instant.
+var (
+	ErrAmbiguousTime   = errors.New("wall clock time is ambiguous in this location")
+	ErrNonexistentTime = errors.New("wall clock time does not exist in this location")
+)
+
+// DSTPolicy selects how a wall clock time is resolved when a daylight saving
+// (or any other UTC offset) transition makes it ambiguous or nonexistent.
+//
+// A wall clock time is ambiguous when the clocks are turned back and the same
+// local time occurs twice, for example 01:30 on the first Sunday of November in
+// New York. It is nonexistent when the clocks jump forward over it, for example
+// 02:30 on the second Sunday of March in New York.
+type DSTPolicy int
+
+const (
+	// DSTEarliest picks the earlier of the two instants of an ambiguous time.
+	// A nonexistent time is moved back by the length of the gap, so 02:30 in a
+	// one hour spring-forward gap becomes 01:30.
+	DSTEarliest DSTPolicy = iota
+
+	// DSTLatest picks the later of the two instants of an ambiguous time.
+	// A nonexistent time is moved forward by the length of the gap, so 02:30 in
+	// a one hour spring-forward gap becomes 03:30.
+	DSTLatest
+
+	// DSTReject returns ErrAmbiguousTime or ErrNonexistentTime instead of
+	// choosing an instant.
+	DSTReject
+
+	// DSTShiftForward picks the earlier instant of an ambiguous time and moves
+	// a nonexistent time to the first instant after the gap, so 02:30 in a one
+	// hour spring-forward gap at 02:00 becomes 03:00. This is how gotime finds
+	// the start of a day whose midnight does not exist.
+	DSTShiftForward
+
+	// DSTCompatible picks the earlier instant of an ambiguous time and moves a
+	// nonexistent time forward by the length of the gap, so 02:30 in a one hour
+	// spring-forward gap becomes 03:30. This matches RFC 5545 and most calendar
+	// applications, and is how gotime places a wall clock time on a date.
+	DSTCompatible
+)
+
+// zoneCache holds the locations loaded by LoadLocation. Loading a location reads
+// and parses the zoneinfo database, which is far more expensive than a map lookup.
+var zoneCache = struct {
+	sync.RWMutex
+	locations map[string]*time.Location
+}{locations: map[string]*time.Location{}}
+
+// LoadLocation returns the location with the given IANA name, such as
+// "America/New_York". Locations are loaded once and cached, so it is cheap to
+// call LoadLocation repeatedly with the same name. "UTC", "Local" and the empty
+// string behave as they do in time.LoadLocation.
+//
+// Example:
+//
+//	loc, err := gotime.LoadLocation("Asia/Kolkata")
+//	if err != nil {
+//		// handle error
+//	}
+func LoadLocation(name string) (*time.Location, error) {
+	zoneCache.RLock()
+	loc, ok := zoneCache.locations[name]
+	zoneCache.RUnlock()
+	if ok {
+		return loc, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneCache.Lock()
+	zoneCache.locations[name] = loc
+	zoneCache.Unlock()
+	return loc, nil
+}
+
+// ToZone returns the same instant as t expressed in the named zone.
+//
+// Example:
+//
+//	t := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+//	tokyo, err := gotime.ToZone(t, "Asia/Tokyo")
+//	// tokyo: 2025-07-01 21:00:00 +0900 JST
+func ToZone(t time.Time, zone string) (time.Time, error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.In(loc), nil
+}
+
+// WallClock returns the current time as seen on a wall clock in the named zone.
+// If a time is provided, it is converted instead of the current time.
+//
+// Example:
+//
+//	now, err := gotime.WallClock("Europe/London")
+//	// now: the current time in London
+func WallClock(zone string, dt ...time.Time) (time.Time, error) {
+	var t time.Time
+	if len(dt) > 0 {
+		t = dt[0]
+	} else {
+		t = Now()
+	}
+	return ToZone(t, zone)
+}
+
+// ConvertZone interprets the wall clock reading of t (ignoring its location) as a
+// time in fromZone and returns that instant expressed in toZone. The policy
+// decides what happens when the reading is ambiguous or does not exist in fromZone.
+//
+// This is useful for values that were parsed without zone information.
+//
+// Example:
+//
+//	meeting := time.Date(2025, 3, 14, 9, 0, 0, 0, time.UTC) // 09:00 in New York
+//	india, err := gotime.ConvertZone(meeting, "America/New_York", "Asia/Kolkata", gotime.DSTReject)
+//	// india: 2025-03-14 18:30:00 +0530 IST
+func ConvertZone(t time.Time, fromZone, toZone string, policy DSTPolicy) (time.Time, error) {
+	from, err := LoadLocation(fromZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	to, err := LoadLocation(toZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	resolved, err := DateInLocation(from, t.Year(), int(t.Month()), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), policy)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return resolved.In(to), nil
+}
+
+// DateInZone builds a time from wall clock components in the named zone. Unlike
+// time.Date, it resolves ambiguous and nonexistent wall clock times with an
+// explicit policy. Out of range components are normalized like time.Date does.
+//
+// Example:
+//
+//	// 01:30 happens twice in New York on 2025-11-02
+//	first, _ := gotime.DateInZone("America/New_York", 2025, 11, 2, 1, 30, 0, 0, gotime.DSTEarliest)
+//	// first: 2025-11-02 01:30:00 -0400 EDT
+//	_, err := gotime.DateInZone("America/New_York", 2025, 11, 2, 1, 30, 0, 0, gotime.DSTReject)
+//	// errors.Is(err, gotime.ErrAmbiguousTime) == true
+func DateInZone(zone string, year, month, day, hour, minute, second, nsec int, policy DSTPolicy) (time.Time, error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return DateInLocation(loc, year, month, day, hour, minute, second, nsec, policy)
+}
+
+// DateInLocation is like DateInZone but takes a *time.Location.
+func DateInLocation(loc *time.Location, year, month, day, hour, minute, second, nsec int, policy DSTPolicy) (time.Time, error) {
+	if loc == time.UTC {
+		return time.Date(year, time.Month(month), day, hour, minute, second, nsec, loc), nil
+	}
+
+	// Normalize the components as a plain wall clock reading first.
+	wall := time.Date(year, time.Month(month), day, hour, minute, second, nsec, time.UTC)
+
+	// The offsets in effect a day either side of the reading cover any single
+	// transition that can affect it.
+	_, offBefore := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, offAfter := wall.Add(24 * time.Hour).In(loc).Zone()
+
+	var candidates []time.Time
+	for i, off := range []int{offBefore, offAfter} {
+		if i == 1 && off == offBefore {
+			break
+		}
+		instant := wall.Add(-time.Duration(off) * time.Second).In(loc)
+		if _, actual := instant.Zone(); actual == off {
+			candidates = append(candidates, instant)
+		}
+	}
+
+	switch len(candidates) {
+	case 1:
+		return candidates[0], nil
+	case 2:
+		if policy == DSTReject {
+			return time.Time{}, fmt.Errorf("%w: %s in %s", ErrAmbiguousTime, wall.Format("2006-01-02 15:04:05"), loc)
+		}
+		if candidates[1].Before(candidates[0]) {
+			candidates[0], candidates[1] = candidates[1], candidates[0]
+		}
+		if policy == DSTLatest {
+			return candidates[1], nil
+		}
+		return candidates[0], nil
+	}
+
+	if offBefore == offAfter {
+		// Not a transition we can reason about; fall back to the standard library.
+		return time.Date(year, time.Month(month), day, hour, minute, second, nsec, loc), nil
+	}
+
+	// The reading falls into a gap. Reading it with the offset after the gap
+	// lands before the transition and vice versa.
+	earlier := wall.Add(-time.Duration(offAfter) * time.Second).In(loc)
+	switch policy {
+	case DSTReject:
+		return time.Time{}, fmt.Errorf("%w: %s in %s", ErrNonexistentTime, wall.Format("2006-01-02 15:04:05"), loc)
+	case DSTEarliest:
+		return earlier, nil
+	case DSTShiftForward:
+		// The zone period of the earlier reading ends at the transition.
+		_, transition := earlier.ZoneBounds()
+		return transition, nil
+	default:
+		return wall.Add(-time.Duration(offBefore) * time.Second).In(loc), nil
+	}
+}
+
+// ZoneTransition describes a change of UTC offset in a location.
+type ZoneTransition struct {
+	// At is the first instant of the new offset, expressed in the location.
+	At time.Time
+
+	// NameBefore and NameAfter are the zone abbreviations, such as "EST" and "EDT".
+	NameBefore string
+	NameAfter  string
+
+	// OffsetBefore and OffsetAfter are the offsets east of UTC.
+	OffsetBefore time.Duration
+	OffsetAfter  time.Duration
+}
+
+// Shift returns how far wall clocks move at the transition. It is positive when
+// clocks spring forward and negative when they fall back.
+func (zt ZoneTransition) Shift() time.Duration {
+	return zt.OffsetAfter - zt.OffsetBefore
+}
+
+// ZoneTransitions lists the UTC offset transitions of the named zone that happen
+// between start and end (inclusive). The order of start and end doesn't matter.
+//
+// Example:
+//
+//	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+//	end := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+//	transitions, err := gotime.ZoneTransitions("Europe/Berlin", start, end)
+//	// transitions[0].At: 2025-03-30 03:00:00 +0200 CEST
+//	// transitions[1].At: 2025-10-26 02:00:00 +0100 CET
+func ZoneTransitions(zone string, start, end time.Time) ([]ZoneTransition, error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return nil, err
+	}
+	if end.Before(start) {
+		start, end = end, start
+	}
+
+	var transitions []ZoneTransition
+	t := start.In(loc)
+	for {
+		_, next := t.ZoneBounds()
+		if next.IsZero() || next.After(end) || !next.After(t) {
+			break
+		}
+		nameBefore, offBefore := t.Zone()
+		nameAfter, offAfter := next.Zone()
+		if offBefore != offAfter {
+			transitions = append(transitions, ZoneTransition{
+				At:           next,
+				NameBefore:   nameBefore,
+				NameAfter:    nameAfter,
+				OffsetBefore: time.Duration(offBefore) * time.Second,
+				OffsetAfter:  time.Duration(offAfter) * time.Second,
+			})
+		}
+		t = next
+	}
+	return transitions, nil
+}
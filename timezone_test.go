@@ -0,0 +1,169 @@
+package gotime_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+	_ "time/tzdata" // Embedded zone database so the tests run without a system tzdata
+
+	"github.com/maniartech/gotime/v2"
+	"github.com/maniartech/gotime/v2/internal/utils"
+)
+
+func mustLoad(t testing.TB, name string) *time.Location {
+	t.Helper()
+	loc, err := gotime.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation(%q): %v", name, err)
+	}
+	return loc
+}
+
+func TestLoadLocationCaches(t *testing.T) {
+	a := mustLoad(t, "America/New_York")
+	b := mustLoad(t, "America/New_York")
+	if a != b {
+		t.Error("Expected the cached *time.Location to be returned")
+	}
+
+	_, err := gotime.LoadLocation("Not/AZone")
+	if err == nil {
+		t.Error("Expected error for unknown zone")
+	}
+}
+
+func TestToZoneAndWallClock(t *testing.T) {
+	instant := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	tokyo, err := gotime.ToZone(instant, "Asia/Tokyo")
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, 21, tokyo.Hour())
+	utils.AssertEqual(t, instant, tokyo)
+
+	london, err := gotime.WallClock("Europe/London", instant)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, 13, london.Hour())
+
+	now, err := gotime.WallClock("Asia/Kolkata")
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, "Asia/Kolkata", now.Location().String())
+
+	_, err = gotime.ToZone(instant, "Bad/Zone")
+	if err == nil {
+		t.Error("Expected error for unknown zone")
+	}
+}
+
+func TestConvertZone(t *testing.T) {
+	wall := time.Date(2025, 3, 14, 9, 0, 0, 0, time.UTC)
+	india, err := gotime.ConvertZone(wall, "America/New_York", "Asia/Kolkata", gotime.DSTReject)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, time.Date(2025, 3, 14, 18, 30, 0, 0, mustLoad(t, "Asia/Kolkata")), india)
+
+	// 02:30 does not exist in New York on 2025-03-09
+	gap := time.Date(2025, 3, 9, 2, 30, 0, 0, time.UTC)
+	_, err = gotime.ConvertZone(gap, "America/New_York", "UTC", gotime.DSTReject)
+	if !errors.Is(err, gotime.ErrNonexistentTime) {
+		t.Errorf("Expected ErrNonexistentTime, got %v", err)
+	}
+
+	_, err = gotime.ConvertZone(gap, "Bad/Zone", "UTC", gotime.DSTReject)
+	if err == nil {
+		t.Error("Expected error for unknown source zone")
+	}
+	_, err = gotime.ConvertZone(gap, "UTC", "Bad/Zone", gotime.DSTReject)
+	if err == nil {
+		t.Error("Expected error for unknown target zone")
+	}
+}
+
+func TestDateInZoneTransitions(t *testing.T) {
+	tests := []struct {
+		name     string
+		zone     string
+		wall     [5]int // year, month, day, hour, minute
+		policy   gotime.DSTPolicy
+		expected time.Time // in UTC
+		err      error
+	}{
+		// New York: clocks jump from 02:00 to 03:00 on 2025-03-09
+		{"NY gap earliest", "America/New_York", [5]int{2025, 3, 9, 2, 30}, gotime.DSTEarliest, time.Date(2025, 3, 9, 6, 30, 0, 0, time.UTC), nil},
+		{"NY gap latest", "America/New_York", [5]int{2025, 3, 9, 2, 30}, gotime.DSTLatest, time.Date(2025, 3, 9, 7, 30, 0, 0, time.UTC), nil},
+		{"NY gap shift forward", "America/New_York", [5]int{2025, 3, 9, 2, 30}, gotime.DSTShiftForward, time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC), nil},
+		{"NY gap compatible", "America/New_York", [5]int{2025, 3, 9, 2, 30}, gotime.DSTCompatible, time.Date(2025, 3, 9, 7, 30, 0, 0, time.UTC), nil},
+		{"NY gap reject", "America/New_York", [5]int{2025, 3, 9, 2, 30}, gotime.DSTReject, time.Time{}, gotime.ErrNonexistentTime},
+		// New York: 01:00-02:00 repeats on 2025-11-02
+		{"NY overlap earliest", "America/New_York", [5]int{2025, 11, 2, 1, 30}, gotime.DSTEarliest, time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC), nil},
+		{"NY overlap latest", "America/New_York", [5]int{2025, 11, 2, 1, 30}, gotime.DSTLatest, time.Date(2025, 11, 2, 6, 30, 0, 0, time.UTC), nil},
+		{"NY overlap shift forward", "America/New_York", [5]int{2025, 11, 2, 1, 30}, gotime.DSTShiftForward, time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC), nil},
+		{"NY overlap compatible", "America/New_York", [5]int{2025, 11, 2, 1, 30}, gotime.DSTCompatible, time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC), nil},
+		{"NY overlap reject", "America/New_York", [5]int{2025, 11, 2, 1, 30}, gotime.DSTReject, time.Time{}, gotime.ErrAmbiguousTime},
+		// Lord Howe Island shifts by 30 minutes
+		{"Lord Howe gap", "Australia/Lord_Howe", [5]int{2025, 10, 5, 2, 15}, gotime.DSTLatest, time.Date(2025, 10, 4, 15, 45, 0, 0, time.UTC), nil},
+		{"Lord Howe overlap", "Australia/Lord_Howe", [5]int{2025, 4, 6, 1, 45}, gotime.DSTLatest, time.Date(2025, 4, 5, 15, 15, 0, 0, time.UTC), nil},
+		// Ordinary times are unaffected by the policy
+		{"Kolkata", "Asia/Kolkata", [5]int{2025, 3, 9, 2, 30}, gotime.DSTReject, time.Date(2025, 3, 8, 21, 0, 0, 0, time.UTC), nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := gotime.DateInZone(tc.zone, tc.wall[0], tc.wall[1], tc.wall[2], tc.wall[3], tc.wall[4], 0, 0, tc.policy)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("Expected %v, got %v", tc.err, err)
+				}
+				return
+			}
+			utils.AssertNoError(t, err)
+			utils.AssertEqual(t, tc.expected, got)
+			utils.AssertEqual(t, tc.zone, got.Location().String())
+		})
+	}
+
+	_, err := gotime.DateInZone("Bad/Zone", 2025, 1, 1, 0, 0, 0, 0, gotime.DSTEarliest)
+	if err == nil {
+		t.Error("Expected error for unknown zone")
+	}
+}
+
+func TestZoneTransitions(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	transitions, err := gotime.ZoneTransitions("Europe/Berlin", end, start)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, 2, len(transitions))
+
+	utils.AssertEqual(t, time.Date(2025, 3, 30, 1, 0, 0, 0, time.UTC), transitions[0].At)
+	utils.AssertEqual(t, "CET", transitions[0].NameBefore)
+	utils.AssertEqual(t, "CEST", transitions[0].NameAfter)
+	utils.AssertEqual(t, time.Hour, transitions[0].Shift())
+
+	utils.AssertEqual(t, time.Date(2025, 10, 26, 1, 0, 0, 0, time.UTC), transitions[1].At)
+	utils.AssertEqual(t, -time.Hour, transitions[1].Shift())
+	utils.AssertEqual(t, 1*time.Hour, transitions[1].OffsetAfter)
+
+	transitions, err = gotime.ZoneTransitions("Asia/Kolkata", start, end)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, 0, len(transitions))
+
+	transitions, err = gotime.ZoneTransitions("UTC", start, end)
+	utils.AssertNoError(t, err)
+	utils.AssertEqual(t, 0, len(transitions))
+
+	_, err = gotime.ZoneTransitions("Bad/Zone", start, end)
+	if err == nil {
+		t.Error("Expected error for unknown zone")
+	}
+}
+
+func ExampleDateInZone() {
+	// 01:30 happens twice in New York when the clocks fall back
+	first, _ := gotime.DateInZone("America/New_York", 2025, 11, 2, 1, 30, 0, 0, gotime.DSTEarliest)
+	second, _ := gotime.DateInZone("America/New_York", 2025, 11, 2, 1, 30, 0, 0, gotime.DSTLatest)
+	fmt.Println(first)
+	fmt.Println(second)
+	// Output:
+	// 2025-11-02 01:30:00 -0400 EDT
+	// 2025-11-02 01:30:00 -0500 EST
+}